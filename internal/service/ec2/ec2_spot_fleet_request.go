@@ -6,16 +6,23 @@ package ec2
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elasticloadbalancingv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,7 +51,11 @@ func resourceSpotFleetRequest() *schema.Resource {
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				d.Set("instance_pools_to_use_count", 1)
+				d.Set("validate_ebs_snapshot_volume_size", false)
+				d.Set("wait_for_fulfillment", false)
+				d.Set("wait_for_scale_in", false)
+				d.Set("wait_for_target_group_health", false)
+
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -59,12 +70,17 @@ func resourceSpotFleetRequest() *schema.Resource {
 		MigrateState:  SpotFleetRequestMigrateState,
 
 		Schema: map[string]*schema.Schema{
+			"activity_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"allocation_strategy": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ForceNew:         true,
 				Default:          awstypes.AllocationStrategyLowestPrice,
-				ValidateDiagFunc: enum.Validate[awstypes.AllocationStrategy](),
+				ValidateDiagFunc: validEnumValueIgnoreCaseAndHyphens[awstypes.AllocationStrategy](),
+				DiffSuppressFunc: suppressEquivalentEnumValue,
 			},
 			"client_token": {
 				Type:     schema.TypeString,
@@ -73,7 +89,6 @@ func resourceSpotFleetRequest() *schema.Resource {
 			"context": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			// Provided constants do not have the correct casing so going with hard-coded values.
@@ -86,6 +101,8 @@ func resourceSpotFleetRequest() *schema.Resource {
 					"NoTermination",
 				}, false),
 			},
+			// The fleet type cannot be modified, so changing it replaces the request.
+			// Spot Fleet request IDs are generated by EC2, so create_before_destroy can be used.
 			"fleet_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -93,6 +110,10 @@ func resourceSpotFleetRequest() *schema.Resource {
 				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.FleetType](),
 			},
+			"fulfilled_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 			"iam_fleet_role": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -100,9 +121,10 @@ func resourceSpotFleetRequest() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"instance_interruption_behaviour": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Default:          awstypes.InstanceInterruptionBehaviorTerminate,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  awstypes.InstanceInterruptionBehaviorTerminate,
+				// ModifySpotFleetRequest does not support changing the interruption behavior.
 				ForceNew:         true,
 				ValidateDiagFunc: enum.Validate[awstypes.InstanceInterruptionBehavior](),
 			},
@@ -112,6 +134,34 @@ func resourceSpotFleetRequest() *schema.Resource {
 				Default:  1,
 				ForceNew: true,
 			},
+			"last_event": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_sub_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"launch_specification": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -119,9 +169,10 @@ func resourceSpotFleetRequest() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ami": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^ami-[0-9a-f]{8,17}$`), "must be a valid AMI ID"),
 						},
 						"associate_public_ip_address": {
 							Type:     schema.TypeBool,
@@ -176,10 +227,11 @@ func resourceSpotFleetRequest() *schema.Resource {
 										ForceNew: true,
 									},
 									names.AttrThroughput: {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Computed: true,
-										ForceNew: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntBetween(125, 1000)),
 									},
 									names.AttrVolumeSize: {
 										Type:     schema.TypeInt,
@@ -214,24 +266,30 @@ func resourceSpotFleetRequest() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"no_device": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
 									names.AttrVirtualName: {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 									},
 								},
 							},
 							Set: hashEphemeralBlockDevice,
 						},
 						"iam_instance_profile": {
-							Type:     schema.TypeString,
-							ForceNew: true,
-							Optional: true,
+							Type:             schema.TypeString,
+							ForceNew:         true,
+							Optional:         true,
+							DiffSuppressFunc: suppressIAMInstanceProfileSiblingDiffs("iam_instance_profile_arn"),
 						},
 						"iam_instance_profile_arn": {
-							Type:         schema.TypeString,
-							ForceNew:     true,
-							Optional:     true,
-							ValidateFunc: verify.ValidARN,
+							Type:             schema.TypeString,
+							ForceNew:         true,
+							Optional:         true,
+							ValidateFunc:     verify.ValidARN,
+							DiffSuppressFunc: suppressIAMInstanceProfileSiblingDiffs("iam_instance_profile"),
 						},
 						names.AttrInstanceType: {
 							Type:     schema.TypeString,
@@ -293,6 +351,8 @@ func resourceSpotFleetRequest() *schema.Resource {
 										Computed: true,
 										ForceNew: true,
 									},
+									// When encrypted is set without a key, AWS may fill in the
+									// account's default EBS KMS key.
 									names.AttrKMSKeyID: {
 										Type:     schema.TypeString,
 										Optional: true,
@@ -300,10 +360,11 @@ func resourceSpotFleetRequest() *schema.Resource {
 										ForceNew: true,
 									},
 									names.AttrThroughput: {
-										Type:     schema.TypeInt,
-										Optional: true,
-										Computed: true,
-										ForceNew: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntBetween(125, 1000)),
 									},
 									names.AttrVolumeSize: {
 										Type:     schema.TypeInt,
@@ -354,9 +415,13 @@ func resourceSpotFleetRequest() *schema.Resource {
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"weighted_capacity": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9]+(\.[0-9]+)?$`), "must be a decimal number"),
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return normalizeDecimalString(old) == normalizeDecimalString(new)
+							},
 						},
 					},
 				},
@@ -364,394 +429,11 @@ func resourceSpotFleetRequest() *schema.Resource {
 				ExactlyOneOf: []string{"launch_specification", "launch_template_config"},
 			},
 			"launch_template_config": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"launch_template_specification": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrID: {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										ValidateFunc: verify.ValidLaunchTemplateID,
-									},
-									names.AttrName: {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										ValidateFunc: verify.ValidLaunchTemplateName,
-									},
-									names.AttrVersion: {
-										Type:         schema.TypeString,
-										Optional:     true,
-										ForceNew:     true,
-										ValidateFunc: validation.StringLenBetween(1, 255),
-									},
-								},
-							},
-						},
-						"overrides": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							ForceNew: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									names.AttrAvailabilityZone: {
-										Type:     schema.TypeString,
-										Optional: true,
-										ForceNew: true,
-									},
-									"instance_requirements": {
-										Type:     schema.TypeList,
-										Optional: true,
-										ForceNew: true,
-										MaxItems: 1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"accelerator_count": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(0),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"accelerator_manufacturers": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:             schema.TypeString,
-														ValidateDiagFunc: enum.Validate[awstypes.AcceleratorManufacturer](),
-													},
-												},
-												"accelerator_names": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:             schema.TypeString,
-														ValidateDiagFunc: enum.Validate[awstypes.AcceleratorName](),
-													},
-												},
-												"accelerator_total_memory_mib": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"accelerator_types": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:             schema.TypeString,
-														ValidateDiagFunc: enum.Validate[awstypes.AcceleratorType](),
-													},
-												},
-												"allowed_instance_types": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 400,
-													Elem:     &schema.Schema{Type: schema.TypeString},
-												},
-												"bare_metal": {
-													Type:             schema.TypeString,
-													Optional:         true,
-													ForceNew:         true,
-													ValidateDiagFunc: enum.Validate[awstypes.BareMetal](),
-												},
-												"baseline_ebs_bandwidth_mbps": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"burstable_performance": {
-													Type:             schema.TypeString,
-													Optional:         true,
-													ForceNew:         true,
-													ValidateDiagFunc: enum.Validate[awstypes.BurstablePerformance](),
-												},
-												"cpu_manufacturers": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:             schema.TypeString,
-														ValidateDiagFunc: enum.Validate[awstypes.CpuManufacturer](),
-													},
-												},
-												"excluded_instance_types": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 400,
-													Elem:     &schema.Schema{Type: schema.TypeString},
-												},
-												"instance_generations": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:             schema.TypeString,
-														ValidateDiagFunc: enum.Validate[awstypes.InstanceGeneration](),
-													},
-												},
-												"local_storage": {
-													Type:             schema.TypeString,
-													Optional:         true,
-													ForceNew:         true,
-													ValidateDiagFunc: enum.Validate[awstypes.LocalStorage](),
-												},
-												"local_storage_types": {
-													Type:     schema.TypeSet,
-													Optional: true,
-													ForceNew: true,
-													Elem: &schema.Schema{
-														Type:             schema.TypeString,
-														ValidateDiagFunc: enum.Validate[awstypes.LocalStorageType](),
-													},
-												},
-												"memory_gib_per_vcpu": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-														},
-													},
-												},
-												"memory_mib": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"network_bandwidth_gbps": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-														},
-													},
-												},
-												"network_interface_count": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-												"on_demand_max_price_percentage_over_lowest_price": {
-													Type:         schema.TypeInt,
-													Optional:     true,
-													ForceNew:     true,
-													ValidateFunc: validation.IntAtLeast(1),
-												},
-												"require_hibernate_support": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													ForceNew: true,
-												},
-												"spot_max_price_percentage_over_lowest_price": {
-													Type:         schema.TypeInt,
-													Optional:     true,
-													ForceNew:     true,
-													ValidateFunc: validation.IntAtLeast(1),
-												},
-												"total_local_storage_gb": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeFloat,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: verify.FloatGreaterThan(0.0),
-															},
-														},
-													},
-												},
-												"vcpu_count": {
-													Type:     schema.TypeList,
-													Optional: true,
-													ForceNew: true,
-													MaxItems: 1,
-													Elem: &schema.Resource{
-														Schema: map[string]*schema.Schema{
-															names.AttrMax: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-															names.AttrMin: {
-																Type:         schema.TypeInt,
-																Optional:     true,
-																ForceNew:     true,
-																ValidateFunc: validation.IntAtLeast(1),
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-									names.AttrInstanceType: {
-										Type:     schema.TypeString,
-										Optional: true,
-										ForceNew: true,
-									},
-									names.AttrPriority: {
-										Type:     schema.TypeFloat,
-										Optional: true,
-										Computed: true,
-										ForceNew: true,
-									},
-									"spot_price": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
-										ForceNew: true,
-									},
-									names.AttrSubnetID: {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
-										ForceNew: true,
-									},
-									"weighted_capacity": {
-										Type:     schema.TypeFloat,
-										Optional: true,
-										Computed: true,
-										ForceNew: true,
-									},
-								},
-							},
-						},
-					},
-				},
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         launchTemplateConfigNestedBlock(),
+				Set:          hashLaunchTemplateConfig,
 				ExactlyOneOf: []string{"launch_specification", "launch_template_config"},
 			},
 			"load_balancers": {
@@ -766,12 +448,17 @@ func resourceSpotFleetRequest() *schema.Resource {
 				Optional:         true,
 				ForceNew:         true,
 				Default:          awstypes.OnDemandAllocationStrategyLowestPrice,
-				ValidateDiagFunc: enum.Validate[awstypes.OnDemandAllocationStrategy](),
+				ValidateDiagFunc: validEnumValueIgnoreCaseAndHyphens[awstypes.OnDemandAllocationStrategy](),
+				DiffSuppressFunc: suppressEquivalentEnumValue,
 			},
 			"on_demand_max_total_price": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9]+(\.[0-9]+)?$`), "must be a decimal number"),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeDecimalString(old) == normalizeDecimalString(new)
+				},
 			},
 			"on_demand_target_capacity": {
 				Type:     schema.TypeInt,
@@ -780,6 +467,7 @@ func resourceSpotFleetRequest() *schema.Resource {
 			"replace_unhealthy_instances": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				// ModifySpotFleetRequest does not support changing unhealthy instance replacement.
 				ForceNew: true,
 				Default:  false,
 			},
@@ -821,8 +509,9 @@ func resourceSpotFleetRequest() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"target_capacity": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"target_capacity_unit_type": {
 				Type:             schema.TypeString,
@@ -850,6 +539,11 @@ func resourceSpotFleetRequest() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"validate_ebs_snapshot_volume_size": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"valid_from": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -867,90 +561,522 @@ func resourceSpotFleetRequest() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"wait_for_scale_in": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"wait_for_target_group_health": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			customizeDiffValidateSpotFleetRequestSpotMaintenanceStrategies,
+			customizeDiffValidateSpotFleetRequestValidUntil,
+			customizeDiffValidateSpotFleetRequestLaunchTemplateSpecification,
+			customizeDiffValidateSpotFleetRequestSnapshotVolumeSizes,
+			customizeDiffValidateSpotFleetRequestIAMInstanceProfile,
+			customizeDiffValidateSpotFleetRequestPlacementTenancy,
+			customizeDiffValidateSpotFleetRequestEphemeralBlockDevices,
+			customizeDiffValidateSpotFleetRequestIOPS,
+			customizeDiffValidateSpotFleetRequestInstanceRequirements,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
-func resourceSpotFleetRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
-
-	_, launchSpecificationOk := d.GetOk("launch_specification")
-
-	// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-SpotFleetRequestConfigData
-	spotFleetConfig := &awstypes.SpotFleetRequestConfigData{
-		ClientToken:                      aws.String(id.UniqueId()),
-		IamFleetRole:                     aws.String(d.Get("iam_fleet_role").(string)),
-		InstanceInterruptionBehavior:     awstypes.InstanceInterruptionBehavior(d.Get("instance_interruption_behaviour").(string)),
-		ReplaceUnhealthyInstances:        aws.Bool(d.Get("replace_unhealthy_instances").(bool)),
-		TagSpecifications:                getTagSpecificationsInV2(ctx, awstypes.ResourceTypeSpotFleetRequest),
-		TargetCapacity:                   aws.Int32(int32(d.Get("target_capacity").(int))),
-		TerminateInstancesWithExpiration: aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
-		Type:                             awstypes.FleetType(d.Get("fleet_type").(string)),
-	}
-
-	if v, ok := d.GetOk("context"); ok {
-		spotFleetConfig.Context = aws.String(v.(string))
-	}
-
-	if launchSpecificationOk {
-		launchSpecs, err := buildSpotFleetLaunchSpecifications(ctx, d, meta)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "creating EC2 Spot Fleet Request: %s", err)
-		}
-		spotFleetConfig.LaunchSpecifications = launchSpecs
-	}
-
-	if v, ok := d.GetOk("launch_template_config"); ok && v.(*schema.Set).Len() > 0 {
-		spotFleetConfig.LaunchTemplateConfigs = expandLaunchTemplateConfigs(v.(*schema.Set).List())
-	}
-
-	if v, ok := d.GetOk("excess_capacity_termination_policy"); ok {
-		spotFleetConfig.ExcessCapacityTerminationPolicy = awstypes.ExcessCapacityTerminationPolicy(v.(string))
-	}
-
-	if v, ok := d.GetOk("allocation_strategy"); ok {
-		spotFleetConfig.AllocationStrategy = awstypes.AllocationStrategy(v.(string))
-	} else {
-		spotFleetConfig.AllocationStrategy = awstypes.AllocationStrategyLowestPrice
-	}
-
-	if v, ok := d.GetOk("instance_pools_to_use_count"); ok && v.(int) != 1 {
-		spotFleetConfig.InstancePoolsToUseCount = aws.Int32(int32(v.(int)))
-	}
-
-	if v, ok := d.GetOk("spot_maintenance_strategies"); ok {
-		spotFleetConfig.SpotMaintenanceStrategies = expandSpotMaintenanceStrategies(v.([]interface{}))
-	}
-
-	// InvalidSpotFleetConfig: SpotMaintenanceStrategies option is only available with the spot fleet type maintain.
-	if d.Get("fleet_type").(string) != string(awstypes.FleetTypeMaintain) {
-		if spotFleetConfig.SpotMaintenanceStrategies != nil {
-			log.Printf("[WARN] Spot Fleet (%s) has an invalid configuration and can not be requested. Capacity Rebalance maintenance strategies can only be specified for spot fleets of type maintain.", d.Id())
-			return diags
-		}
-	}
-
-	if v, ok := d.GetOk("spot_price"); ok {
-		spotFleetConfig.SpotPrice = aws.String(v.(string))
-	}
-
-	spotFleetConfig.OnDemandTargetCapacity = aws.Int32(int32(d.Get("on_demand_target_capacity").(int)))
-
-	if v, ok := d.GetOk("on_demand_allocation_strategy"); ok {
-		spotFleetConfig.OnDemandAllocationStrategy = awstypes.OnDemandAllocationStrategy(v.(string))
-	}
-
-	if v, ok := d.GetOk("on_demand_max_total_price"); ok {
-		spotFleetConfig.OnDemandMaxTotalPrice = aws.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("valid_from"); ok {
-		v, _ := time.Parse(time.RFC3339, v.(string))
-
-		spotFleetConfig.ValidFrom = aws.Time(v)
+// launchTemplateConfigNestedBlock returns the schema of a Spot fleet launch template configuration.
+// It is also used to hash launch template configurations, see hashLaunchTemplateConfig.
+func launchTemplateConfigNestedBlock() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"launch_template_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidLaunchTemplateID,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidLaunchTemplateName,
+						},
+						names.AttrVersion: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringLenBetween(1, 255),
+							DiffSuppressFunc: suppressLaunchTemplateVersionDefault,
+						},
+					},
+				},
+			},
+			"overrides": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     launchTemplateOverridesNestedBlock(),
+				Set:      hashLaunchTemplateOverrides,
+			},
+		},
+	}
+}
+
+// launchTemplateOverridesNestedBlock returns the schema of a Spot fleet launch template override.
+// It is also used to hash overrides, see hashLaunchTemplateOverrides.
+func launchTemplateOverridesNestedBlock() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrAvailabilityZone: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_requirements": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accelerator_count": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"accelerator_manufacturers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.AcceleratorManufacturer](),
+							},
+						},
+						"accelerator_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.AcceleratorName](),
+							},
+						},
+						"accelerator_total_memory_mib": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"accelerator_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.AcceleratorType](),
+							},
+						},
+						"allowed_instance_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 400,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validInstanceTypePattern,
+							},
+						},
+						"bare_metal": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.BareMetal](),
+						},
+						"baseline_ebs_bandwidth_mbps": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"burstable_performance": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.BurstablePerformance](),
+						},
+						"cpu_manufacturers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.CpuManufacturer](),
+							},
+						},
+						"excluded_instance_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 400,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validInstanceTypePattern,
+							},
+						},
+						"instance_generations": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.InstanceGeneration](),
+							},
+						},
+						"local_storage": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.LocalStorage](),
+						},
+						"local_storage_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.LocalStorageType](),
+							},
+						},
+						"memory_gib_per_vcpu": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.FloatGreaterThan(0.0),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.FloatGreaterThan(0.0),
+									},
+								},
+							},
+						},
+						"memory_mib": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"network_bandwidth_gbps": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.FloatGreaterThan(0.0),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.FloatGreaterThan(0.0),
+									},
+								},
+							},
+						},
+						"network_interface_count": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"on_demand_max_price_percentage_over_lowest_price": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"require_hibernate_support": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"spot_max_price_percentage_over_lowest_price": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"total_local_storage_gb": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.FloatGreaterThan(0.0),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.FloatGreaterThan(0.0),
+									},
+								},
+							},
+						},
+						"vcpu_count": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrMax: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrMin: {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrInstanceType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrPriority: {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"spot_price": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeDecimalString(old) == normalizeDecimalString(new)
+				},
+			},
+			names.AttrSubnetID: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"weighted_capacity": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSpotFleetRequestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	_, launchSpecificationOk := d.GetOk("launch_specification")
+
+	// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-SpotFleetRequestConfigData
+	spotFleetConfig := &awstypes.SpotFleetRequestConfigData{
+		ClientToken:                      aws.String(id.UniqueId()),
+		IamFleetRole:                     aws.String(d.Get("iam_fleet_role").(string)),
+		InstanceInterruptionBehavior:     awstypes.InstanceInterruptionBehavior(d.Get("instance_interruption_behaviour").(string)),
+		ReplaceUnhealthyInstances:        aws.Bool(d.Get("replace_unhealthy_instances").(bool)),
+		TagSpecifications:                getTagSpecificationsInV2(ctx, awstypes.ResourceTypeSpotFleetRequest),
+		TargetCapacity:                   aws.Int32(int32(d.Get("target_capacity").(int))),
+		TerminateInstancesWithExpiration: aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
+		Type:                             awstypes.FleetType(d.Get("fleet_type").(string)),
+	}
+
+	if v, ok := d.GetOk("context"); ok {
+		spotFleetConfig.Context = aws.String(v.(string))
+	}
+
+	if launchSpecificationOk {
+		launchSpecs, err := buildSpotFleetLaunchSpecifications(ctx, d, meta)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 Spot Fleet Request: %s", err)
+		}
+		spotFleetConfig.LaunchSpecifications = launchSpecs
+
+		// Snapshots that were not known at plan time are checked here, see resourceSpotFleetRequestCustomizeDiff.
+		if d.Get("validate_ebs_snapshot_volume_size").(bool) {
+			snapshotSizes, err := findSpotFleetLaunchSpecificationSnapshotSizes(ctx, conn, launchSpecs)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "checking EC2 Spot Fleet Request EBS volume sizes: %s", err)
+			}
+
+			if err := validSpotFleetSnapshotVolumeSizes(launchSpecs, snapshotSizes); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating EC2 Spot Fleet Request: %s", err)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("launch_template_config"); ok && v.(*schema.Set).Len() > 0 {
+		spotFleetConfig.LaunchTemplateConfigs = expandLaunchTemplateConfigs(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("excess_capacity_termination_policy"); ok {
+		spotFleetConfig.ExcessCapacityTerminationPolicy = awstypes.ExcessCapacityTerminationPolicy(v.(string))
+	}
+
+	if v, ok := d.GetOk("allocation_strategy"); ok {
+		spotFleetConfig.AllocationStrategy = canonicalEnumValue[awstypes.AllocationStrategy](v.(string))
+	} else {
+		spotFleetConfig.AllocationStrategy = awstypes.AllocationStrategyLowestPrice
+	}
+
+	if v, ok := d.GetOk("instance_pools_to_use_count"); ok && v.(int) != 1 {
+		spotFleetConfig.InstancePoolsToUseCount = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("spot_maintenance_strategies"); ok {
+		spotFleetConfig.SpotMaintenanceStrategies = expandSpotMaintenanceStrategies(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("spot_price"); ok {
+		spotFleetConfig.SpotPrice = aws.String(v.(string))
+	}
+
+	spotFleetConfig.OnDemandTargetCapacity = aws.Int32(int32(d.Get("on_demand_target_capacity").(int)))
+
+	if v, ok := d.GetOk("on_demand_allocation_strategy"); ok {
+		spotFleetConfig.OnDemandAllocationStrategy = canonicalEnumValue[awstypes.OnDemandAllocationStrategy](v.(string))
+	}
+
+	if v, ok := d.GetOk("on_demand_max_total_price"); ok {
+		spotFleetConfig.OnDemandMaxTotalPrice = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("valid_from"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+
+		spotFleetConfig.ValidFrom = aws.Time(v)
 	}
 
 	if v, ok := d.GetOk("valid_until"); ok {
@@ -989,40 +1115,339 @@ func resourceSpotFleetRequestCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if v, ok := d.GetOk("target_capacity_unit_type"); ok {
-		spotFleetConfig.TargetCapacityUnitType = awstypes.TargetCapacityUnitType(v.(string))
+	if v, ok := d.GetOk("target_capacity_unit_type"); ok {
+		spotFleetConfig.TargetCapacityUnitType = awstypes.TargetCapacityUnitType(v.(string))
+	}
+
+	// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-RequestSpotFleetInput
+	input := &ec2.RequestSpotFleetInput{
+		SpotFleetRequestConfig: spotFleetConfig,
+	}
+
+	// The request retries and the waits below share the create timeout.
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutCreate))
+
+	log.Printf("[DEBUG] Creating EC2 Spot Fleet Request: %s", d.Id())
+	output, err := requestSpotFleet(ctx, conn.RequestSpotFleet, input, min(spotFleetRequestIAMPropagationTimeout, deadline.Remaining()))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Spot Fleet Request: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.SpotFleetRequestId))
+
+	if _, err := waitSpotFleetRequestCreated(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Spot Fleet Request (%s) create: %s", d.Id(), err)
+	}
+
+	waitForTargetGroupHealth := d.Get("wait_for_target_group_health").(bool) && d.Get("target_group_arns").(*schema.Set).Len() > 0
+	// Nothing is fulfilled for a fleet without capacity, so there is nothing to wait for.
+	if (d.Get("wait_for_fulfillment").(bool) || waitForTargetGroupHealth) && d.Get("target_capacity").(int)+d.Get("on_demand_target_capacity").(int) > 0 {
+		if _, err := waitSpotFleetRequestFulfilled(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Spot Fleet Request (%s) fulfillment: %s", d.Id(), err)
+		}
+
+		// Fulfilled instances only serve traffic once they pass their target groups' health checks.
+		if waitForTargetGroupHealth {
+			targetGroupARNs := flex.ExpandStringValueSet(d.Get("target_group_arns").(*schema.Set))
+			if err := waitSpotFleetRequestTargetsHealthy(ctx, conn, meta.(*conns.AWSClient).ELBV2Client(ctx), d.Id(), targetGroupARNs, deadline.Remaining()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 Spot Fleet Request (%s) targets healthy: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceSpotFleetRequestRead(ctx, d, meta)...)
+}
+
+// spotFleetRequestTargetsHealthy returns whether each of the specified instances
+// is registered and healthy in a target group with the specified target health descriptions.
+func spotFleetRequestTargetsHealthy(instanceIDs []string, apiObjects []elasticloadbalancingv2types.TargetHealthDescription) bool {
+	healthy := make(map[string]bool)
+	for _, apiObject := range apiObjects {
+		if apiObject.Target == nil || apiObject.TargetHealth == nil {
+			continue
+		}
+
+		if apiObject.TargetHealth.State == elasticloadbalancingv2types.TargetHealthStateEnumHealthy {
+			healthy[aws.ToString(apiObject.Target.Id)] = true
+		}
+	}
+
+	for _, id := range instanceIDs {
+		if !healthy[id] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// requestSpotFleet requests a Spot Fleet, retrying while its IAM fleet role or instance profile propagates.
+// Every attempt sends the same input, and so the same client token.
+func requestSpotFleet(ctx context.Context, f func(context.Context, *ec2.RequestSpotFleetInput, ...func(*ec2.Options)) (*ec2.RequestSpotFleetOutput, error), input *ec2.RequestSpotFleetInput, timeout time.Duration) (*ec2.RequestSpotFleetOutput, error) {
+	outputRaw, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return f(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if isSpotFleetRequestIAMPropagationError(err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*ec2.RequestSpotFleetOutput), nil
+}
+
+// isSpotFleetRequestIAMPropagationError returns whether the specified error is caused by an
+// IAM fleet role or instance profile that has not yet propagated.
+func isSpotFleetRequestIAMPropagationError(err error) bool {
+	return tfawserr.ErrMessageContains(err, errCodeInvalidSpotFleetRequestConfig, "IamFleetRole") ||
+		tfawserr.ErrMessageContains(err, errCodeInvalidSpotFleetRequestConfig, "Invalid IAM Instance Profile") ||
+		tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Invalid IAM Instance Profile")
+}
+
+// spotFleetRequestIAMPropagationTimeout bounds the retries of a Spot Fleet request while its IAM fleet role
+// or instance profile propagates, which can take longer than for other resources.
+const spotFleetRequestIAMPropagationTimeout = 2 * iamPropagationTimeout
+
+// validSpotFleetInstanceRequirementsRanges returns an error if a range in the specified raw instance requirements
+// configuration has a maximum less than its minimum, or is an accelerator count range without either.
+func validSpotFleetInstanceRequirementsRanges(instanceRequirements cty.Value) error {
+	if !instanceRequirements.IsKnown() || instanceRequirements.IsNull() {
+		return nil
+	}
+
+	for _, v := range instanceRequirements.AsValueSlice() {
+		for _, k := range []string{"accelerator_count", "accelerator_total_memory_mib", "memory_gib_per_vcpu", "network_bandwidth_gbps"} {
+			ranges := v.GetAttr(k)
+			if !ranges.IsKnown() || ranges.IsNull() {
+				continue
+			}
+
+			for _, v := range ranges.AsValueSlice() {
+				minimum, maximum := v.GetAttr(names.AttrMin), v.GetAttr(names.AttrMax)
+				if !minimum.IsKnown() || !maximum.IsKnown() {
+					continue
+				}
+
+				if k == "accelerator_count" && minimum.IsNull() && maximum.IsNull() {
+					return errors.New(`"launch_template_config.overrides.instance_requirements.accelerator_count" must specify "min" or "max"`)
+				}
+
+				if !minimum.IsNull() && !maximum.IsNull() && maximum.AsBigFloat().Cmp(minimum.AsBigFloat()) < 0 {
+					return fmt.Errorf(`"launch_template_config.overrides.instance_requirements.%s.max" must not be less than "min"`, k)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// spotFleetRequestVolumeMinIOPS are the minimum provisioned IOPS of the EBS volume types that support them.
+// Maximums are left to the API as they change over time and depend on the volume size.
+// See https://docs.aws.amazon.com/ebs/latest/userguide/ebs-volume-types.html.
+var spotFleetRequestVolumeMinIOPS = map[awstypes.VolumeType]int64{
+	awstypes.VolumeTypeGp3: 3000,
+	awstypes.VolumeTypeIo1: 100,
+	awstypes.VolumeTypeIo2: 100,
+}
+
+// customizeDiffValidateSpotFleetRequestSpotMaintenanceStrategies validates that `spot_maintenance_strategies` is only set on "maintain" fleets.
+func customizeDiffValidateSpotFleetRequestSpotMaintenanceStrategies(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// InvalidSpotFleetConfig: SpotMaintenanceStrategies option is only available with the spot fleet type maintain.
+	if diff.Get("fleet_type").(string) != string(awstypes.FleetTypeMaintain) {
+		if _, ok := diff.GetOk("spot_maintenance_strategies"); ok {
+			return errors.New(`"spot_maintenance_strategies" can only be specified when "fleet_type" is "maintain"`)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffValidateSpotFleetRequestValidUntil validates that a new `valid_until` is in the future.
+func customizeDiffValidateSpotFleetRequestValidUntil(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A request that expires before it is made is rejected. Existing requests may have expired since.
+	if diff.Id() == "" || diff.HasChange("valid_until") {
+		if v, ok := diff.Get("valid_until").(string); ok && v != "" {
+			if validUntil, err := time.Parse(time.RFC3339, v); err == nil && !validUntil.After(time.Now()) {
+				return fmt.Errorf(`"valid_until" (%s) must be in the future`, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffValidateSpotFleetRequestLaunchTemplateSpecification validates that a launch template
+// is identified by exactly one of its ID or name.
+func customizeDiffValidateSpotFleetRequestLaunchTemplateSpecification(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, v := range spotFleetRequestRawConfigList(diff.GetRawConfig().GetAttr("launch_template_config")) {
+		specification := v.GetAttr("launch_template_specification")
+		if !specification.IsKnown() || specification.IsNull() || specification.LengthInt() == 0 {
+			continue
+		}
+
+		specification = specification.AsValueSlice()[0]
+		if id, name := specification.GetAttr(names.AttrID), specification.GetAttr(names.AttrName); id.IsNull() == name.IsNull() {
+			return errors.New(`exactly one of "launch_template_config.launch_template_specification.id" or "launch_template_config.launch_template_specification.name" must be specified`)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffValidateSpotFleetRequestSnapshotVolumeSizes validates, when `validate_ebs_snapshot_volume_size` is set,
+// that no EBS volume of a launch specification is smaller than its snapshot.
+func customizeDiffValidateSpotFleetRequestSnapshotVolumeSizes(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Only EBS volumes of launch specifications are checked against their snapshot.
+	if v := diff.GetRawConfig().GetAttr("validate_ebs_snapshot_volume_size"); v.IsKnown() && !v.IsNull() && v.True() {
+		if v := diff.GetRawConfig().GetAttr("launch_template_config"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return errors.New(`"validate_ebs_snapshot_volume_size" can only be set with "launch_specification"`)
+		}
+	}
+
+	// Spot Fleet accepts volumes smaller than their snapshot but then fails to launch instances.
+	// Checking costs a DescribeSnapshots call per snapshot, so it is opt-in.
+	if (diff.Id() == "" || diff.HasChange("launch_specification")) && diff.Get("validate_ebs_snapshot_volume_size").(bool) {
+		launchSpecs := spotFleetLaunchSpecificationSnapshotVolumes(diff.Get("launch_specification").(*schema.Set).List())
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+		snapshotSizes, err := findSpotFleetLaunchSpecificationSnapshotSizes(ctx, conn, launchSpecs)
+		if err != nil {
+			return fmt.Errorf("checking EC2 Spot Fleet Request EBS volume sizes: %w", err)
+		}
+
+		if err := validSpotFleetSnapshotVolumeSizes(launchSpecs, snapshotSizes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffValidateSpotFleetRequestIAMInstanceProfile validates that a launch specification's
+// instance profile is identified by either its name or its ARN, not both.
+func customizeDiffValidateSpotFleetRequestIAMInstanceProfile(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, v := range spotFleetRequestRawConfigList(diff.GetRawConfig().GetAttr("launch_specification")) {
+		if name, arn := v.GetAttr("iam_instance_profile"), v.GetAttr("iam_instance_profile_arn"); !name.IsNull() && !arn.IsNull() {
+			return errors.New(`only one of "launch_specification.iam_instance_profile" or "launch_specification.iam_instance_profile_arn" can be specified`)
+		}
 	}
 
-	// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-RequestSpotFleetInput
-	input := &ec2.RequestSpotFleetInput{
-		SpotFleetRequestConfig: spotFleetConfig,
+	return nil
+}
+
+// customizeDiffValidateSpotFleetRequestPlacementTenancy validates that no launch specification uses the host tenancy.
+func customizeDiffValidateSpotFleetRequestPlacementTenancy(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// SpotPlacement has no host ID, and the host tenancy is not supported for Spot Instances.
+	for _, v := range spotFleetRequestRawConfigList(diff.GetRawConfig().GetAttr("launch_specification")) {
+		if v := v.GetAttr("placement_tenancy"); v.IsKnown() && !v.IsNull() && v.AsString() == string(awstypes.TenancyHost) {
+			return errors.New(`host tenancy is not supported for Spot Instances, "launch_specification.placement_tenancy" must be "default" or "dedicated"`)
+		}
 	}
 
-	log.Printf("[DEBUG] Creating EC2 Spot Fleet Request: %s", d.Id())
-	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, iamPropagationTimeout,
-		func() (interface{}, error) {
-			return conn.RequestSpotFleet(ctx, input)
-		},
-		errCodeInvalidSpotFleetRequestConfig, "SpotFleetRequestConfig.IamFleetRole",
-	)
+	return nil
+}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating EC2 Spot Fleet Request: %s", err)
+// customizeDiffValidateSpotFleetRequestEphemeralBlockDevices validates that an ephemeral block device
+// either maps an instance store volume or suppresses one mapped by the AMI.
+func customizeDiffValidateSpotFleetRequestEphemeralBlockDevices(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, v := range spotFleetRequestRawConfigList(diff.GetRawConfig().GetAttr("launch_specification")) {
+		for _, v := range spotFleetRequestRawConfigList(v.GetAttr("ephemeral_block_device")) {
+			noDevice, virtualName := v.GetAttr("no_device"), v.GetAttr(names.AttrVirtualName)
+			if !noDevice.IsKnown() || !virtualName.IsKnown() {
+				continue
+			}
+
+			noDeviceSet, virtualNameSet := !noDevice.IsNull() && noDevice.True(), !virtualName.IsNull() && virtualName.AsString() != ""
+			switch {
+			case noDeviceSet && virtualNameSet:
+				return errors.New(`only one of "launch_specification.ephemeral_block_device.virtual_name" or "launch_specification.ephemeral_block_device.no_device" can be specified`)
+			case !noDeviceSet && !virtualNameSet:
+				return errors.New(`"launch_specification.ephemeral_block_device.virtual_name" must be specified unless "launch_specification.ephemeral_block_device.no_device" is true`)
+			}
+		}
 	}
 
-	d.SetId(aws.ToString(outputRaw.(*ec2.RequestSpotFleetOutput).SpotFleetRequestId))
+	return nil
+}
+
+// customizeDiffValidateSpotFleetRequestIOPS validates the minimum provisioned IOPS of launch specification volumes,
+// which depends on the volume type.
+func customizeDiffValidateSpotFleetRequestIOPS(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, v := range spotFleetRequestRawConfigList(diff.GetRawConfig().GetAttr("launch_specification")) {
+		for _, k := range []string{"ebs_block_device", "root_block_device"} {
+			for _, v := range spotFleetRequestRawConfigList(v.GetAttr(k)) {
+				iops, volumeType := v.GetAttr(names.AttrIOPS), v.GetAttr(names.AttrVolumeType)
+				if !iops.IsKnown() || iops.IsNull() || !volumeType.IsKnown() || volumeType.IsNull() {
+					continue
+				}
 
-	if _, err := waitSpotFleetRequestCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Spot Fleet Request (%s) create: %s", d.Id(), err)
+				minIOPS, ok := spotFleetRequestVolumeMinIOPS[awstypes.VolumeType(volumeType.AsString())]
+				if !ok {
+					continue
+				}
+
+				if n, _ := iops.AsBigFloat().Int64(); n != 0 && n < minIOPS {
+					return fmt.Errorf(`"launch_specification.%s.iops" must be at least %d for volume type %q, got: %d`, k, minIOPS, volumeType.AsString(), n)
+				}
+			}
+		}
 	}
 
-	if d.Get("wait_for_fulfillment").(bool) {
-		if _, err := waitSpotFleetRequestFulfilled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Spot Fleet Request (%s) fulfillment: %s", d.Id(), err)
+	return nil
+}
+
+// customizeDiffValidateSpotFleetRequestInstanceRequirements validates the instance requirements of launch template overrides.
+func customizeDiffValidateSpotFleetRequestInstanceRequirements(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, v := range spotFleetRequestRawConfigList(diff.GetRawConfig().GetAttr("launch_template_config")) {
+		for _, v := range spotFleetRequestRawConfigList(v.GetAttr("overrides")) {
+			instanceRequirements := v.GetAttr("instance_requirements")
+
+			// Instance requirement ranges are checked from the raw configuration, in which an unset maximum
+			// can be told apart from a maximum of 0.
+			if err := validSpotFleetInstanceRequirementsRanges(instanceRequirements); err != nil {
+				return err
+			}
+
+			if !instanceRequirements.IsKnown() || instanceRequirements.IsNull() || instanceRequirements.LengthInt() == 0 {
+				continue
+			}
+
+			// With attribute-based instance type selection the maximum price is controlled by the
+			// instance requirements' price protection percentages rather than by a fixed Spot price.
+			// The raw configuration is used as an override's spot_price is Computed.
+			if !v.GetAttr("spot_price").IsNull() {
+				return errors.New(`"launch_template_config.overrides.spot_price" cannot be specified with "launch_template_config.overrides.instance_requirements", use "instance_requirements.spot_max_price_percentage_over_lowest_price" instead`)
+			}
+
+			// Local storage size constraints contradict excluding instance types with local storage.
+			instanceRequirements = instanceRequirements.AsValueSlice()[0]
+			if localStorage, totalLocalStorageGB := instanceRequirements.GetAttr("local_storage"), instanceRequirements.GetAttr("total_local_storage_gb"); localStorage.IsKnown() && !localStorage.IsNull() && localStorage.AsString() == string(awstypes.LocalStorageExcluded) && totalLocalStorageGB.IsKnown() && !totalLocalStorageGB.IsNull() && totalLocalStorageGB.LengthInt() > 0 {
+				return errors.New(`"launch_template_config.overrides.instance_requirements.total_local_storage_gb" cannot be specified when "local_storage" is "excluded"`)
+			}
 		}
 	}
 
-	return append(diags, resourceSpotFleetRequestRead(ctx, d, meta)...)
+	return nil
+}
+
+// spotFleetRequestRawConfigList returns the elements of the specified raw configuration list or set,
+// or none if it is null or not yet known.
+func spotFleetRequestRawConfigList(v cty.Value) []cty.Value {
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	return v.AsValueSlice()
 }
 
 func resourceSpotFleetRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1041,6 +1466,7 @@ func resourceSpotFleetRequestRead(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Spot Fleet Request (%s): %s", d.Id(), err)
 	}
 
+	d.Set("activity_status", output.ActivityStatus)
 	d.Set("spot_request_state", output.SpotFleetRequestState)
 
 	config := output.SpotFleetRequestConfig
@@ -1049,24 +1475,36 @@ func resourceSpotFleetRequestRead(ctx context.Context, d *schema.ResourceData, m
 
 	// The default of this argument does not get set in the create operation
 	// Therefore if the API does not return a value, being a *int32 type it will result in 0 and always create a diff.
+	// The API only returns the value for the lowestPrice allocation strategy, so the stored value is kept,
+	// and the default is only set when there is none, e.g. on import.
 	if config.InstancePoolsToUseCount != nil { // nosemgrep:ci.helper-schema-ResourceData-Set-extraneous-nil-check
 		d.Set("instance_pools_to_use_count", config.InstancePoolsToUseCount)
+	} else if _, ok := d.GetOk("instance_pools_to_use_count"); !ok {
+		d.Set("instance_pools_to_use_count", 1)
 	}
 
-	d.Set("client_token", config.ClientToken)
+	// The client token is not always returned. Keep the one generated on creation rather than clearing it.
+	if config.ClientToken != nil { // nosemgrep:ci.helper-schema-ResourceData-Set-extraneous-nil-check
+		d.Set("client_token", config.ClientToken)
+	}
 	d.Set("context", config.Context)
 	d.Set("excess_capacity_termination_policy", config.ExcessCapacityTerminationPolicy)
+	d.Set("fulfilled_capacity", config.FulfilledCapacity)
 	d.Set("iam_fleet_role", config.IamFleetRole)
 	d.Set("spot_maintenance_strategies", flattenSpotMaintenanceStrategies(config.SpotMaintenanceStrategies))
 	d.Set("spot_price", config.SpotPrice)
-	d.Set("target_capacity", config.TargetCapacity)
+	d.Set("target_capacity", aws.ToInt32(config.TargetCapacity))
 	d.Set("target_capacity_unit_type", config.TargetCapacityUnitType)
 	d.Set("terminate_instances_with_expiration", config.TerminateInstancesWithExpiration)
+	// terminate_instances_on_delete is only used when cancelling the request and is not returned by the API.
 	if config.ValidFrom != nil {
 		d.Set("valid_from", aws.ToTime(config.ValidFrom).Format(time.RFC3339))
 	}
+	// A request without an end date and time does not expire.
 	if config.ValidUntil != nil {
 		d.Set("valid_until", aws.ToTime(config.ValidUntil).Format(time.RFC3339))
+	} else {
+		d.Set("valid_until", nil)
 	}
 
 	launchSpec, err := launchSpecsToSet(ctx, conn, config.LaunchSpecifications)
@@ -1080,13 +1518,18 @@ func resourceSpotFleetRequestRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("fleet_type", config.Type)
 	d.Set("launch_specification", launchSpec)
 
+	// DescribeSpotFleetRequests returns the request's complete tag set (at most 50 tags per resource),
+	// so no separate DescribeTags call is needed.
 	setTagsOutV2(ctx, output.Tags)
 
-	if err := d.Set("launch_template_config", flattenLaunchTemplateConfigs(config.LaunchTemplateConfigs)); err != nil {
+	// "$Default" is resolved to a version number when the request is made.
+	launchTemplateConfigs := launchTemplateConfigsWithVersionDefault(flattenLaunchTemplateConfigs(config.LaunchTemplateConfigs), d.Get("launch_template_config").(*schema.Set).List())
+	if err := d.Set("launch_template_config", launchTemplateConfigs); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting launch_template_config: %s", err)
 	}
 
-	d.Set("on_demand_target_capacity", config.OnDemandTargetCapacity)
+	// OnDemandTargetCapacity is not returned when only Spot capacity was requested.
+	d.Set("on_demand_target_capacity", aws.ToInt32(config.OnDemandTargetCapacity))
 	d.Set("on_demand_allocation_strategy", config.OnDemandAllocationStrategy)
 	d.Set("on_demand_max_total_price", config.OnDemandMaxTotalPrice)
 
@@ -1114,6 +1557,23 @@ func resourceSpotFleetRequestRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// The most recent history event explains the activity status, e.g. why a request is in error.
+	// Only the last hour of history is looked at, so last_event is empty if nothing happened recently.
+	// The history is informational only, so failing to read it, e.g. for lack of the
+	// ec2:DescribeSpotFleetRequestHistory permission, leaves last_event unchanged.
+	record, err := findSpotFleetRequestLatestHistoryRecordByID(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("last_event", nil)
+	case err != nil:
+		log.Printf("[WARN] reading EC2 Spot Fleet Request (%s) history: %s", d.Id(), err)
+	default:
+		if err := d.Set("last_event", []interface{}{flattenSpotFleetRequestHistoryRecord(record)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting last_event: %s", err)
+		}
+	}
+
 	return diags
 }
 
@@ -1122,11 +1582,13 @@ func resourceSpotFleetRequestUpdate(ctx context.Context, d *schema.ResourceData,
 
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChanges("target_capacity", "on_demand_target_capacity", "excess_capacity_termination_policy", "context") {
 		input := &ec2.ModifySpotFleetRequestInput{
 			SpotFleetRequestId: aws.String(d.Id()),
 		}
 
+		// A target capacity of 0 pauses a maintain fleet. Running instances are terminated
+		// unless the excess capacity termination policy is "NoTermination".
 		if d.HasChange("target_capacity") {
 			input.TargetCapacity = aws.Int32(int32(d.Get("target_capacity").(int)))
 		}
@@ -1136,9 +1598,13 @@ func resourceSpotFleetRequestUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 
 		if d.HasChange("excess_capacity_termination_policy") {
-			if val, ok := d.GetOk("excess_capacity_termination_policy"); ok {
-				input.ExcessCapacityTerminationPolicy = awstypes.ExcessCapacityTerminationPolicy(val.(string))
-			}
+			input.ExcessCapacityTerminationPolicy = awstypes.ExcessCapacityTerminationPolicy(d.Get("excess_capacity_termination_policy").(string))
+		}
+
+		// An empty context can't be configured, so an empty value here means the argument
+		// was removed and is sent as-is to clear the previously set context.
+		if d.HasChange("context") {
+			input.Context = aws.String(d.Get("context").(string))
 		}
 
 		log.Printf("[DEBUG] Modifying EC2 Spot Fleet Request: %s", d.Id())
@@ -1149,6 +1615,13 @@ func resourceSpotFleetRequestUpdate(ctx context.Context, d *schema.ResourceData,
 		if _, err := waitSpotFleetRequestUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Spot Fleet Request (%s) update: %s", d.Id(), err)
 		}
+
+		// Excess instances are terminated asynchronously once the modification completes.
+		if o, n := d.GetChange("target_capacity"); d.Get("wait_for_scale_in").(bool) && n.(int) < o.(int) && !strings.EqualFold(d.Get("excess_capacity_termination_policy").(string), string(awstypes.ExcessCapacityTerminationPolicyNoTermination)) {
+			if _, err := waitSpotFleetRequestScaledIn(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 Spot Fleet Request (%s) scale-in: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceSpotFleetRequestRead(ctx, d, meta)...)
@@ -1389,10 +1862,17 @@ func readSpotFleetBlockDeviceMappingsFromConfig(ctx context.Context, d map[strin
 		vL := v.(*schema.Set).List()
 		for _, v := range vL {
 			bd := v.(map[string]interface{})
-			blockDevices = append(blockDevices, awstypes.BlockDeviceMapping{
+			bdm := awstypes.BlockDeviceMapping{
 				DeviceName:  aws.String(bd[names.AttrDeviceName].(string)),
 				VirtualName: aws.String(bd[names.AttrVirtualName].(string)),
-			})
+			}
+			if v, ok := bd["no_device"].(bool); ok && v {
+				// Suppress the instance store volume mapped by the AMI.
+				bdm.NoDevice = aws.String("")
+				bdm.VirtualName = nil
+			}
+
+			blockDevices = append(blockDevices, bdm)
 		}
 	}
 
@@ -1467,6 +1947,101 @@ func buildSpotFleetLaunchSpecifications(ctx context.Context, d *schema.ResourceD
 	return specs, nil
 }
 
+func findSpotFleetLaunchSpecificationSnapshotSizes(ctx context.Context, conn *ec2.Client, launchSpecs []awstypes.SpotFleetLaunchSpecification) (map[string]int32, error) {
+	snapshotSizes := make(map[string]int32)
+
+	for _, launchSpec := range launchSpecs {
+		for _, blockDevice := range launchSpec.BlockDeviceMappings {
+			if blockDevice.Ebs == nil || blockDevice.Ebs.SnapshotId == nil || blockDevice.Ebs.VolumeSize == nil {
+				continue
+			}
+
+			snapshotID := aws.ToString(blockDevice.Ebs.SnapshotId)
+			if _, ok := snapshotSizes[snapshotID]; ok {
+				continue
+			}
+
+			snapshot, err := findSnapshotByID(ctx, conn, snapshotID)
+
+			if err != nil {
+				return nil, fmt.Errorf("reading EBS Snapshot (%s): %w", snapshotID, err)
+			}
+
+			snapshotSizes[snapshotID] = aws.ToInt32(snapshot.VolumeSize)
+		}
+	}
+
+	return snapshotSizes, nil
+}
+
+// spotFleetLaunchSpecificationSnapshotVolumes returns the EBS block devices of the configured launch specifications
+// that set both a snapshot ID and a volume size. Values not yet known are omitted.
+func spotFleetLaunchSpecificationSnapshotVolumes(tfList []interface{}) []awstypes.SpotFleetLaunchSpecification {
+	var launchSpecs []awstypes.SpotFleetLaunchSpecification
+
+	for _, v := range tfList {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["ebs_block_device"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		var launchSpec awstypes.SpotFleetLaunchSpecification
+		for _, v := range v.List() {
+			tfMap := v.(map[string]interface{})
+
+			snapshotID, _ := tfMap[names.AttrSnapshotID].(string)
+			volumeSize, _ := tfMap[names.AttrVolumeSize].(int)
+			if snapshotID == "" || volumeSize == 0 {
+				continue
+			}
+
+			launchSpec.BlockDeviceMappings = append(launchSpec.BlockDeviceMappings, awstypes.BlockDeviceMapping{
+				DeviceName: aws.String(tfMap[names.AttrDeviceName].(string)),
+				Ebs: &awstypes.EbsBlockDevice{
+					SnapshotId: aws.String(snapshotID),
+					VolumeSize: aws.Int32(int32(volumeSize)),
+				},
+			})
+		}
+
+		if len(launchSpec.BlockDeviceMappings) > 0 {
+			launchSpecs = append(launchSpecs, launchSpec)
+		}
+	}
+
+	return launchSpecs
+}
+
+// validSpotFleetSnapshotVolumeSizes returns an error for each EBS volume that is smaller than its snapshot.
+func validSpotFleetSnapshotVolumeSizes(launchSpecs []awstypes.SpotFleetLaunchSpecification, snapshotSizes map[string]int32) error {
+	var errs []error
+
+	for _, launchSpec := range launchSpecs {
+		for _, blockDevice := range launchSpec.BlockDeviceMappings {
+			if blockDevice.Ebs == nil || blockDevice.Ebs.SnapshotId == nil || blockDevice.Ebs.VolumeSize == nil {
+				continue
+			}
+
+			snapshotID := aws.ToString(blockDevice.Ebs.SnapshotId)
+			snapshotSize, ok := snapshotSizes[snapshotID]
+			if !ok {
+				continue
+			}
+
+			if volumeSize := aws.ToInt32(blockDevice.Ebs.VolumeSize); volumeSize < snapshotSize {
+				errs = append(errs, fmt.Errorf(`"launch_specification.ebs_block_device" (%s) "volume_size" (%d GiB) must be at least the size of snapshot %s (%d GiB)`, aws.ToString(blockDevice.DeviceName), volumeSize, snapshotID, snapshotSize))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func expandLaunchTemplateConfig(tfMap map[string]interface{}) awstypes.LaunchTemplateConfig {
 	apiObject := awstypes.LaunchTemplateConfig{}
 
@@ -1474,6 +2049,7 @@ func expandLaunchTemplateConfig(tfMap map[string]interface{}) awstypes.LaunchTem
 		apiObject.LaunchTemplateSpecification = expandFleetLaunchTemplateSpecification(v[0].(map[string]interface{}))
 	}
 
+	// A template-only configuration uses the launch template's defaults; AWS rejects an empty overrides list.
 	if v, ok := tfMap["overrides"].(*schema.Set); ok && v.Len() > 0 {
 		apiObject.Overrides = expandLaunchTemplateOverrideses(v.List())
 	}
@@ -1648,6 +2224,10 @@ func expandInstanceRequirements(tfMap map[string]interface{}) *awstypes.Instance
 		apiObject.MemoryMiB = expandMemoryMiB(v[0].(map[string]interface{}))
 	}
 
+	if v, ok := tfMap["network_bandwidth_gbps"].([]interface{}); ok && len(v) > 0 {
+		apiObject.NetworkBandwidthGbps = expandNetworkBandwidthGbps(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["network_interface_count"].([]interface{}); ok && len(v) > 0 {
 		apiObject.NetworkInterfaceCount = expandNetworkInterfaceCount(v[0].(map[string]interface{}))
 	}
@@ -1656,6 +2236,7 @@ func expandInstanceRequirements(tfMap map[string]interface{}) *awstypes.Instance
 		apiObject.OnDemandMaxPricePercentageOverLowestPrice = aws.Int32(int32(v))
 	}
 
+	// The API omits RequireHibernateSupport when false, which flattens back to the zero value.
 	if v, ok := tfMap["require_hibernate_support"].(bool); ok && v {
 		apiObject.RequireHibernateSupport = aws.Bool(v)
 	}
@@ -1682,12 +2263,14 @@ func expandAcceleratorCount(tfMap map[string]interface{}) *awstypes.AcceleratorC
 
 	apiObject := &awstypes.AcceleratorCount{}
 
-	if v, ok := tfMap[names.AttrMax].(int); ok {
-		apiObject.Max = aws.Int32(int32(v))
+	if v, ok := tfMap[names.AttrMin].(int); ok && v != 0 {
+		apiObject.Min = aws.Int32(int32(v))
 	}
 
-	if v, ok := tfMap[names.AttrMin].(int); ok {
-		apiObject.Min = aws.Int32(int32(v))
+	// A maximum of 0 excludes instance types with accelerators. It cannot be configured with a minimum,
+	// and a range without a minimum must specify a maximum, so without a minimum the maximum is set.
+	if v, ok := tfMap[names.AttrMax].(int); ok && (v != 0 || apiObject.Min == nil) {
+		apiObject.Max = aws.Int32(int32(v))
 	}
 
 	return apiObject
@@ -1700,14 +2283,15 @@ func expandAcceleratorTotalMemoryMiB(tfMap map[string]interface{}) *awstypes.Acc
 
 	apiObject := &awstypes.AcceleratorTotalMemoryMiB{}
 
-	if v, ok := tfMap[names.AttrMax].(int); ok {
-		apiObject.Max = aws.Int32(int32(v))
-	}
-
 	if v, ok := tfMap[names.AttrMin].(int); ok {
 		apiObject.Min = aws.Int32(int32(v))
 	}
 
+	// The maximum is at least 1 when configured.
+	if v, ok := tfMap[names.AttrMax].(int); ok && v != 0 {
+		apiObject.Max = aws.Int32(int32(v))
+	}
+
 	return apiObject
 }
 
@@ -1736,14 +2320,15 @@ func expandMemoryGiBPerVCPU(tfMap map[string]interface{}) *awstypes.MemoryGiBPer
 
 	apiObject := &awstypes.MemoryGiBPerVCpu{}
 
-	if v, ok := tfMap[names.AttrMax].(float64); ok {
-		apiObject.Max = aws.Float64(v)
-	}
-
 	if v, ok := tfMap[names.AttrMin].(float64); ok {
 		apiObject.Min = aws.Float64(v)
 	}
 
+	// The maximum is greater than 0 when configured.
+	if v, ok := tfMap[names.AttrMax].(float64); ok && v != 0 {
+		apiObject.Max = aws.Float64(v)
+	}
+
 	return apiObject
 }
 
@@ -1765,6 +2350,25 @@ func expandMemoryMiB(tfMap map[string]interface{}) *awstypes.MemoryMiB {
 	return apiObject
 }
 
+func expandNetworkBandwidthGbps(tfMap map[string]interface{}) *awstypes.NetworkBandwidthGbps {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.NetworkBandwidthGbps{}
+
+	if v, ok := tfMap[names.AttrMin].(float64); ok {
+		apiObject.Min = aws.Float64(v)
+	}
+
+	// The maximum is greater than 0 when configured.
+	if v, ok := tfMap[names.AttrMax].(float64); ok && v != 0 {
+		apiObject.Max = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
 func expandNetworkInterfaceCount(tfMap map[string]interface{}) *awstypes.NetworkInterfaceCount {
 	if tfMap == nil {
 		return nil
@@ -1929,12 +2533,14 @@ func launchSpecToMap(ctx context.Context, l awstypes.SpotFleetLaunchSpecificatio
 	m[names.AttrVPCSecurityGroupIDs] = securityGroupIds
 
 	if l.WeightedCapacity != nil {
-		m["weighted_capacity"] = strconv.FormatFloat(*l.WeightedCapacity, 'f', 0, 64)
+		// Fractional weights, e.g. "2.5", must not be rounded or they would force a new resource.
+		m["weighted_capacity"] = strconv.FormatFloat(aws.ToFloat64(l.WeightedCapacity), 'f', -1, 64)
 	}
 
 	if l.TagSpecifications != nil {
 		for _, tagSpecs := range l.TagSpecifications {
 			// only "instance" tags are currently supported: http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotFleetTagSpecification.html
+			// "spot-instances-request" tag specifications are rejected by the API.
 			if tagSpecs.ResourceType == awstypes.ResourceTypeInstance {
 				m[names.AttrTags] = keyValueTagsV2(ctx, tagSpecs.Tags).IgnoreAWS().Map()
 			}
@@ -2004,7 +2610,17 @@ func ephemeralBlockDevicesToSet(bdm []awstypes.BlockDeviceMapping) *schema.Set {
 	set := &schema.Set{F: hashEphemeralBlockDevice}
 
 	for _, val := range bdm {
-		if val.VirtualName != nil {
+		if val.NoDevice != nil {
+			m := make(map[string]interface{})
+			m["no_device"] = true
+			m[names.AttrVirtualName] = ""
+
+			if val.DeviceName != nil {
+				m[names.AttrDeviceName] = aws.ToString(val.DeviceName)
+			}
+
+			set.Add(m)
+		} else if val.VirtualName != nil {
 			m := make(map[string]interface{})
 			m[names.AttrVirtualName] = aws.ToString(val.VirtualName)
 
@@ -2024,6 +2640,11 @@ func rootBlockDeviceToSet(bdm []awstypes.BlockDeviceMapping, rootDevName *string
 
 	if rootDevName != nil {
 		for _, val := range bdm {
+			// The root device may be mapped to an instance store volume or suppressed.
+			if val.Ebs == nil {
+				continue
+			}
+
 			if aws.ToString(val.DeviceName) == aws.ToString(rootDevName) {
 				m := make(map[string]interface{})
 				if val.Ebs.DeleteOnTermination != nil {
@@ -2065,8 +2686,15 @@ func rootBlockDeviceToSet(bdm []awstypes.BlockDeviceMapping, rootDevName *string
 func hashEphemeralBlockDevice(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m[names.AttrDeviceName].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m[names.AttrVirtualName].(string)))
+	if v, ok := m[names.AttrDeviceName].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+	if v, ok := m[names.AttrVirtualName].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+	if v, ok := m["no_device"].(bool); ok && v {
+		buf.WriteString(fmt.Sprintf("%t-", v))
+	}
 	return create.StringHashcode(buf.String())
 }
 
@@ -2075,6 +2703,21 @@ func hashRootBlockDevice(v interface{}) int {
 	return 0
 }
 
+// suppressIAMInstanceProfileSiblingDiffs suppresses the removal of a launch specification's
+// IAM instance profile name or ARN when the other one is configured.
+// DescribeSpotFleetRequests can return both the name and the ARN of the instance profile.
+func suppressIAMInstanceProfileSiblingDiffs(sibling string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if old == "" || new != "" {
+			return false
+		}
+
+		k = k[:strings.LastIndex(k, ".")+1] + sibling
+
+		return d.Get(k).(string) != ""
+	}
+}
+
 func hashLaunchSpecification(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -2090,6 +2733,51 @@ func hashLaunchSpecification(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
+var launchTemplateConfigHash = schema.HashResource(launchTemplateConfigNestedBlock())
+
+// hashLaunchTemplateConfig hashes a launch template configuration with a numeric launch template version
+// hashed as "$Default", so that "$Default" and the version number it was resolved to are the same set element
+// and suppressLaunchTemplateVersionDefault decides whether they differ.
+// All other attributes are hashed as the default set hash would.
+func hashLaunchTemplateConfig(v interface{}) int {
+	m := maps.Clone(v.(map[string]interface{}))
+	if tfMap := spotFleetLaunchTemplateSpecification(m); tfMap != nil {
+		if v, _ := tfMap[names.AttrVersion].(string); isLaunchTemplateVersionNumber(v) {
+			tfMap = maps.Clone(tfMap)
+			tfMap[names.AttrVersion] = LaunchTemplateVersionDefault
+			m["launch_template_specification"] = []interface{}{tfMap}
+		}
+	}
+
+	return launchTemplateConfigHash(m)
+}
+
+// suppressLaunchTemplateVersionDefault suppresses the difference between a configured "$Default" launch template version
+// and the version number the API resolved it to when the Spot fleet request was made.
+func suppressLaunchTemplateVersionDefault(k, old, new string, d *schema.ResourceData) bool {
+	return new == LaunchTemplateVersionDefault && isLaunchTemplateVersionNumber(old)
+}
+
+func isLaunchTemplateVersionNumber(v string) bool {
+	_, err := strconv.ParseInt(v, 10, 64)
+
+	return err == nil
+}
+
+var launchTemplateOverridesHash = schema.HashResource(launchTemplateOverridesNestedBlock())
+
+// hashLaunchTemplateOverrides hashes a launch template override with its Spot price normalized,
+// as the API may return an equivalent price in a different form than the configured one.
+// All other attributes are hashed as the default set hash would.
+func hashLaunchTemplateOverrides(v interface{}) int {
+	m := maps.Clone(v.(map[string]interface{}))
+	if v, ok := m["spot_price"].(string); ok {
+		m["spot_price"] = normalizeDecimalString(v)
+	}
+
+	return launchTemplateOverridesHash(m)
+}
+
 func hashEBSBlockDevice(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -2130,6 +2818,51 @@ func flattenLaunchTemplateConfigs(apiObjects []awstypes.LaunchTemplateConfig) []
 	return tfList
 }
 
+// spotFleetLaunchTemplateSpecification returns the launch template specification of a launch template configuration.
+func spotFleetLaunchTemplateSpecification(v interface{}) map[string]interface{} {
+	if tfMap, ok := v.(map[string]interface{}); ok {
+		if v, ok := tfMap["launch_template_specification"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			return v[0].(map[string]interface{})
+		}
+	}
+
+	return nil
+}
+
+// launchTemplateConfigsWithVersionDefault replaces the launch template version number returned by the API
+// with "$Default" wherever that was the previously configured version of the same launch template.
+func launchTemplateConfigsWithVersionDefault(tfList, configured []interface{}) []interface{} {
+	for _, v := range tfList {
+		apiSpecification := spotFleetLaunchTemplateSpecification(v)
+		if apiSpecification == nil {
+			continue
+		}
+
+		if version, _ := apiSpecification[names.AttrVersion].(string); !isLaunchTemplateVersionNumber(version) {
+			continue
+		}
+
+		for _, v := range configured {
+			configuredSpecification := spotFleetLaunchTemplateSpecification(v)
+			if configuredSpecification == nil || configuredSpecification[names.AttrVersion] != LaunchTemplateVersionDefault {
+				continue
+			}
+
+			if id, _ := configuredSpecification[names.AttrID].(string); id == "" || id != apiSpecification[names.AttrID] {
+				if name, _ := configuredSpecification[names.AttrName].(string); name == "" || name != apiSpecification[names.AttrName] {
+					continue
+				}
+			}
+
+			apiSpecification[names.AttrVersion] = LaunchTemplateVersionDefault
+
+			break
+		}
+	}
+
+	return tfList
+}
+
 func flattenFleetLaunchTemplateSpecificationForSpotFleetRequest(apiObject *awstypes.FleetLaunchTemplateSpecification) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -2152,6 +2885,19 @@ func flattenFleetLaunchTemplateSpecificationForSpotFleetRequest(apiObject *awsty
 	return tfMap
 }
 
+// normalizeDecimalString returns the shortest representation of a decimal string such as a price,
+// e.g. "0.0500" and "5e-02" both become "0.05", so that equivalent values do not produce a diff.
+// Values that are not numbers are returned unchanged.
+func normalizeDecimalString(v string) string {
+	f, err := strconv.ParseFloat(v, 64)
+
+	if err != nil {
+		return v
+	}
+
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
 func flattenLaunchTemplateOverrides(apiObject awstypes.LaunchTemplateOverrides) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
@@ -2172,7 +2918,7 @@ func flattenLaunchTemplateOverrides(apiObject awstypes.LaunchTemplateOverrides)
 	}
 
 	if v := apiObject.SpotPrice; v != nil {
-		tfMap["spot_price"] = aws.ToString(v)
+		tfMap["spot_price"] = normalizeDecimalString(aws.ToString(v))
 	}
 
 	if v := apiObject.SubnetId; v != nil {
@@ -2200,6 +2946,57 @@ func flattenLaunchTemplateOverrideses(apiObjects []awstypes.LaunchTemplateOverri
 	return tfList
 }
 
+// spotFleetRequestHistoryRetention is how long EC2 keeps a Spot Fleet request's history.
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeSpotFleetRequestHistory.html.
+const spotFleetRequestHistoryRetention = 48 * time.Hour
+
+// spotFleetRequestLatestHistoryWindow is how far back the lookup of a Spot Fleet request's most recent event goes.
+// It is kept short as the lookup is made on every read, whereas paging through the full retention period is not.
+const spotFleetRequestLatestHistoryWindow = 1 * time.Hour
+
+// spotFleetRequestHistoryStartTime returns the start of the window used when waiting on Spot Fleet request history.
+// It covers the full 48 hour retention period so that no retained event is missed.
+func spotFleetRequestHistoryStartTime() time.Time {
+	return time.Now().Add(-spotFleetRequestHistoryRetention)
+}
+
+// spotFleetRequestLatestHistoryStartTime returns the start of the window used to look up a Spot Fleet request's most recent event.
+func spotFleetRequestLatestHistoryStartTime() time.Time {
+	return time.Now().Add(-spotFleetRequestLatestHistoryWindow)
+}
+
+// latestSpotFleetRequestHistoryRecord returns the most recent of the specified history records, or nil if there are none.
+// Of records with the same timestamp, the last one returned is considered the most recent.
+func latestSpotFleetRequestHistoryRecord(apiObjects []awstypes.HistoryRecord) *awstypes.HistoryRecord {
+	var latest *awstypes.HistoryRecord
+
+	for i, v := range apiObjects {
+		if latest == nil || !aws.ToTime(v.Timestamp).Before(aws.ToTime(latest.Timestamp)) {
+			latest = &apiObjects[i]
+		}
+	}
+
+	return latest
+}
+
+func flattenSpotFleetRequestHistoryRecord(apiObject *awstypes.HistoryRecord) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"event_type": string(apiObject.EventType),
+	}
+
+	if v := apiObject.EventInformation; v != nil {
+		tfMap[names.AttrDescription] = aws.ToString(v.EventDescription)
+		tfMap["event_sub_type"] = aws.ToString(v.EventSubType)
+		tfMap[names.AttrInstanceID] = aws.ToString(v.InstanceId)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
 func flattenSpotMaintenanceStrategies(spotMaintenanceStrategies *awstypes.SpotMaintenanceStrategies) []interface{} {
 	if spotMaintenanceStrategies == nil {
 		return []interface{}{}
@@ -2223,3 +3020,48 @@ func flattenSpotCapacityRebalance(spotCapacityRebalance *awstypes.SpotCapacityRe
 
 	return []interface{}{m}
 }
+
+// canonicalEnumValue returns the enum value that matches the specified string, ignoring case and hyphens.
+func canonicalEnumValue[T enum.Valueser[T]](v string) T {
+	if e, ok := findCanonicalEnumValue[T](v); ok {
+		return e
+	}
+
+	return T(v)
+}
+
+func findCanonicalEnumValue[T enum.Valueser[T]](v string) (T, bool) {
+	for _, e := range enum.EnumValues[T]() {
+		if enumValueKey(string(e)) == enumValueKey(v) {
+			return e, true
+		}
+	}
+
+	return "", false
+}
+
+// enumValueKey returns the form in which enum values are compared, e.g. "lowest-price" and "LowestPrice" both match "lowestPrice".
+func enumValueKey(v string) string {
+	return strings.ToLower(strings.ReplaceAll(v, "-", ""))
+}
+
+// validEnumValueIgnoreCaseAndHyphens is like enum.ValidateIgnoreCase, but also ignores hyphens.
+func validEnumValueIgnoreCaseAndHyphens[T enum.Valueser[T]]() schema.SchemaValidateDiagFunc {
+	return validation.ToDiagFunc(func(i interface{}, k string) (ws []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if _, ok := findCanonicalEnumValue[T](v); !ok {
+			es = append(es, fmt.Errorf("expected %s to be one of %q, got %s", k, enum.Values[T](), v))
+		}
+
+		return
+	})
+}
+
+func suppressEquivalentEnumValue(k, old, new string, d *schema.ResourceData) bool {
+	return enumValueKey(old) == enumValueKey(new)
+}
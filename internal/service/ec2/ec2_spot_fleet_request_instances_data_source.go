@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_spot_fleet_request_instances", name="Spot Fleet Request Instances")
+func dataSourceSpotFleetRequestInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSpotFleetRequestInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_health": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.InstanceHealthStatus](),
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_health": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spot_instance_request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"spot_fleet_request_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceSpotFleetRequestInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	spotFleetRequestID := d.Get("spot_fleet_request_id").(string)
+	input := &ec2.DescribeSpotFleetInstancesInput{
+		SpotFleetRequestId: aws.String(spotFleetRequestID),
+	}
+
+	output, err := findSpotFleetInstances(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Spot Fleet Request (%s) instances: %s", spotFleetRequestID, err)
+	}
+
+	var instances []interface{}
+	for _, v := range filterActiveInstancesByHealth(output, awstypes.InstanceHealthStatus(d.Get("instance_health").(string))) {
+		instances = append(instances, flattenActiveInstance(v))
+	}
+
+	d.SetId(spotFleetRequestID)
+	if err := d.Set("instances", instances); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
+	}
+
+	return diags
+}
+
+// filterActiveInstancesByHealth returns the instances with the given health status, or all instances if it is empty.
+func filterActiveInstancesByHealth(apiObjects []awstypes.ActiveInstance, instanceHealth awstypes.InstanceHealthStatus) []awstypes.ActiveInstance {
+	if instanceHealth == "" {
+		return apiObjects
+	}
+
+	var filtered []awstypes.ActiveInstance
+	for _, v := range apiObjects {
+		if v.InstanceHealth == instanceHealth {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered
+}
+
+func flattenActiveInstance(apiObject awstypes.ActiveInstance) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"instance_health": string(apiObject.InstanceHealth),
+	}
+
+	if v := apiObject.InstanceId; v != nil {
+		tfMap[names.AttrInstanceID] = aws.ToString(v)
+	}
+
+	if v := apiObject.InstanceType; v != nil {
+		tfMap[names.AttrInstanceType] = aws.ToString(v)
+	}
+
+	if v := apiObject.SpotInstanceRequestId; v != nil {
+		tfMap["spot_instance_request_id"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
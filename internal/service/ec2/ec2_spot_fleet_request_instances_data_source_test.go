@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFilterActiveInstancesByHealth(t *testing.T) {
+	t.Parallel()
+
+	healthy := awstypes.ActiveInstance{
+		InstanceHealth: awstypes.InstanceHealthStatusHealthyStatus,
+		InstanceId:     aws.String("i-00000000000000001"),
+	}
+	unhealthy := awstypes.ActiveInstance{
+		InstanceHealth: awstypes.InstanceHealthStatusUnhealthyStatus,
+		InstanceId:     aws.String("i-00000000000000002"),
+	}
+	unknown := awstypes.ActiveInstance{
+		InstanceId: aws.String("i-00000000000000003"),
+	}
+	apiObjects := []awstypes.ActiveInstance{healthy, unhealthy, unknown}
+
+	testCases := map[string]struct {
+		instanceHealth awstypes.InstanceHealthStatus
+		expected       []string
+	}{
+		"no filter": {
+			expected: []string{"i-00000000000000001", "i-00000000000000002", "i-00000000000000003"},
+		},
+		"healthy": {
+			instanceHealth: awstypes.InstanceHealthStatusHealthyStatus,
+			expected:       []string{"i-00000000000000001"},
+		},
+		"unhealthy": {
+			instanceHealth: awstypes.InstanceHealthStatusUnhealthyStatus,
+			expected:       []string{"i-00000000000000002"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range tfec2.FilterActiveInstancesByHealth(apiObjects, testCase.instanceHealth) {
+				got = append(got, aws.ToString(v.InstanceId))
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccEC2SpotFleetRequestInstancesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	dataSourceName := "data.aws_spot_fleet_request_instances.test"
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestInstancesDataSourceConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.instance_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.instance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.spot_instance_request_id"),
+					resource.TestCheckResourceAttrSet("data.aws_spot_fleet_request_instances.unhealthy", "instances.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpotFleetRequestInstancesDataSourceConfig_basic(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil), `
+data "aws_spot_fleet_request_instances" "test" {
+  spot_fleet_request_id = aws_spot_fleet_request.test.id
+}
+
+data "aws_spot_fleet_request_instances" "unhealthy" {
+  spot_fleet_request_id = aws_spot_fleet_request.test.id
+  instance_health       = "unhealthy"
+}
+`)
+}
@@ -4,11 +4,16 @@
 package ec2_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,8 +21,16 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elasticloadbalancingv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -58,23 +71,18 @@ func TestAccEC2SpotFleetRequest_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_context(t *testing.T) {
+func TestAccEC2SpotFleetRequest_status(t *testing.T) {
 	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
-	contextId := "test_context"
-
-	// Receiving this error is confirmation that the Context ID was included in the spot fleet request.
-	errRegexp, err := regexp.Compile(fmt.Sprintf(`UnauthorizedOperation: The account "\d+" is not allowed to access Context "%s".`, contextId))
-	if err != nil {
-		t.Fatalf("error compiling expected error regexp: %s", err)
-	}
+	resourceName := "aws_spot_fleet_request.test"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -88,20 +96,26 @@ func TestAccEC2SpotFleetRequest_context(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccSpotFleetRequestConfig_context(rName, publicKey, validUntil, contextId),
-				ExpectError: errRegexp,
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "activity_status", "fulfilled"),
+					resource.TestCheckResourceAttr(resourceName, "fulfilled_capacity", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "last_event.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "last_event.0.event_type"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_event.0.timestamp"),
+				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_targetCapacityUnitType(t *testing.T) {
+func TestAccEC2SpotFleetRequest_clientToken(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
-	targetCapacityUnitType := "vcpu"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -115,27 +129,22 @@ func TestAccEC2SpotFleetRequest_targetCapacityUnitType(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_targetCapacityUnitType(rName, publicKey, validUntil, targetCapacityUnitType),
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "Default"),
-					resource.TestCheckResourceAttr(resourceName, "valid_until", validUntil),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "target_capacity_unit_type", targetCapacityUnitType),
+					resource.TestCheckResourceAttrSet(resourceName, "client_token"),
+					testAccCheckSpotFleetRequestClientToken(resourceName, &sfr),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config:   testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_disappears(t *testing.T) {
+func TestAccEC2SpotFleetRequest_spotTargetCapacityOnly(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -157,15 +166,19 @@ func TestAccEC2SpotFleetRequest_disappears(t *testing.T) {
 				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSpotFleetRequest(), resourceName),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_target_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
 				),
-				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_tags(t *testing.T) {
+func TestAccEC2SpotFleetRequest_zeroTargetCapacityWaitForFulfillment(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -184,45 +197,21 @@ func TestAccEC2SpotFleetRequest_tags(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_tags1(rName, publicKey, validUntil, acctest.CtKey1, acctest.CtValue1),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
-			},
-			{
-				Config: testAccSpotFleetRequestConfig_tags2(rName, publicKey, validUntil, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
-				),
-			},
-			{
-				Config: testAccSpotFleetRequestConfig_tags1(rName, publicKey, validUntil, acctest.CtKey2, acctest.CtValue2),
+				Config: testAccSpotFleetRequestConfig_zeroTargetCapacityWaitForFulfillment(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_fulfillment", acctest.CtTrue),
 				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_associatePublicIPAddress(t *testing.T) {
+func TestAccEC2SpotFleetRequest_noValidUntil(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
@@ -237,32 +226,38 @@ func TestAccEC2SpotFleetRequest_associatePublicIPAddress(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_associatePublicIPAddress(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_noValidUntil(rName, publicKey),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
 					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
-						"associate_public_ip_address": acctest.CtTrue,
-					}),
+					resource.TestCheckNoResourceAttr(resourceName, "valid_until"),
 				),
 			},
+			{
+				Config:   testAccSpotFleetRequestConfig_noValidUntil(rName, publicKey),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "terminate_instances_on_delete", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_launchTemplate(t *testing.T) {
+func TestAccEC2SpotFleetRequest_context(t *testing.T) {
 	ctx := acctest.Context(t)
-	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
-	resourceName := "aws_spot_fleet_request.test"
+	contextId := "test_context"
+
+	// Receiving this error is confirmation that the Context ID was included in the spot fleet request.
+	errRegexp, err := regexp.Compile(fmt.Sprintf(`UnauthorizedOperation: The account "\d+" is not allowed to access Context "%s".`, contextId))
+	if err != nil {
+		t.Fatalf("error compiling expected error regexp: %s", err)
+	}
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -276,30 +271,26 @@ func TestAccEC2SpotFleetRequest_launchTemplate(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchTemplate(rName, publicKey, validUntil),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config:      testAccSpotFleetRequestConfig_context(rName, publicKey, validUntil, contextId),
+				ExpectError: errRegexp,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_LaunchTemplate_multiple(t *testing.T) {
+func TestAccEC2SpotFleetRequest_contextUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
+	contextId := "test_context"
+
+	// Receiving this error is confirmation that the Context ID was included in the modification request.
+	errRegexp, err := regexp.Compile(fmt.Sprintf(`UnauthorizedOperation: The account "\d+" is not allowed to access Context "%s".`, contextId))
+	if err != nil {
+		t.Fatalf("error compiling expected error regexp: %s", err)
+	}
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -313,24 +304,32 @@ func TestAccEC2SpotFleetRequest_LaunchTemplate_multiple(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchTemplateMultiple(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "context", ""),
 				),
 			},
+			{
+				Config: testAccSpotFleetRequestConfig_context(rName, publicKey, validUntil, contextId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectError: errRegexp,
+			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceTypeOverrides(t *testing.T) {
+func TestAccEC2SpotFleetRequest_targetCapacityUnitType(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
+	targetCapacityUnitType := "vcpu"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -344,39 +343,27 @@ func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceTypeOverrides(t *testi
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceTypeOverrides(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_targetCapacityUnitType(rName, publicKey, validUntil, targetCapacityUnitType),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
 					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*", map[string]string{
-						"overrides.#": acctest.Ct2,
-					}),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
-						"instance_requirements.#": acctest.Ct0,
-						names.AttrInstanceType:    "t1.micro",
-						"weighted_capacity":       acctest.Ct2,
-					}),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
-						"instance_requirements.#": acctest.Ct0,
-						names.AttrInstanceType:    "m3.medium",
-						names.AttrPriority:        acctest.Ct1,
-						"spot_price":              "0.26",
-					}),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "Default"),
+					resource.TestCheckResourceAttr(resourceName, "valid_until", validUntil),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_unit_type", targetCapacityUnitType),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceRequirementsOverrides(t *testing.T) {
+func TestAccEC2SpotFleetRequest_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -395,41 +382,20 @@ func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceRequirementsOverrides(
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverrides(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*", map[string]string{
-						"overrides.#": acctest.Ct1,
-					}),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
-						"instance_requirements.#":                        acctest.Ct1,
-						"instance_requirements.0.instance_generations.#": acctest.Ct1,
-						"instance_requirements.0.memory_mib.#":           acctest.Ct1,
-						"instance_requirements.0.memory_mib.0.max":       "50000",
-						"instance_requirements.0.memory_mib.0.min":       "500",
-						"instance_requirements.0.vcpu_count.#":           acctest.Ct1,
-						"instance_requirements.0.vcpu_count.0.max":       "8",
-						"instance_requirements.0.vcpu_count.0.min":       acctest.Ct1,
-						names.AttrInstanceType:                           "",
-					}),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSpotFleetRequest(), resourceName),
 				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_launchTemplateToLaunchSpec(t *testing.T) {
+func TestAccEC2SpotFleetRequest_tags(t *testing.T) {
 	ctx := acctest.Context(t)
-	var before, after awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -446,37 +412,43 @@ func TestAccEC2SpotFleetRequest_launchTemplateToLaunchSpec(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchTemplate(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_tags1(rName, publicKey, validUntil, acctest.CtKey1, acctest.CtValue1),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_tags2(rName, publicKey, validUntil, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
-					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_tags1(rName, publicKey, validUntil, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
 				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_launchSpecToLaunchTemplate(t *testing.T) {
+func TestAccEC2SpotFleetRequest_tagsMaximum(t *testing.T) {
 	ctx := acctest.Context(t)
-	var before, after awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -493,29 +465,29 @@ func TestAccEC2SpotFleetRequest_launchSpecToLaunchTemplate(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_tagsCount(rName, publicKey, validUntil, 50),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "50"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key0", "value0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key49", "value49"),
 				),
 			},
 			{
-				Config: testAccSpotFleetRequestConfig_launchTemplate(rName, publicKey, validUntil),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
-					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
-				),
+				Config:   testAccSpotFleetRequestConfig_tagsCount(rName, publicKey, validUntil, 50),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_onDemandTargetCapacity(t *testing.T) {
+func TestAccEC2SpotFleetRequest_tagsOutOfBand(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -534,37 +506,31 @@ func TestAccEC2SpotFleetRequest_onDemandTargetCapacity(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil, 0),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "on_demand_target_capacity", acctest.Ct0),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
-			},
-			{
-				Config: testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil, 1),
+				Config: testAccSpotFleetRequestConfig_tags1(rName, publicKey, validUntil, acctest.CtKey1, acctest.CtValue1),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "on_demand_target_capacity", acctest.Ct1),
+					testAccCheckSpotFleetRequestUpdateTags(ctx, &sfr, map[string]string{acctest.CtKey1: acctest.CtValue1}, map[string]string{acctest.CtKey1: acctest.CtValue1Updated, acctest.CtKey2: acctest.CtValue2}),
 				),
+				ExpectNonEmptyPlan: true,
 			},
 			{
-				Config: testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil, 0),
+				Config: testAccSpotFleetRequestConfig_tags1(rName, publicKey, validUntil, acctest.CtKey1, acctest.CtValue1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "on_demand_target_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
 				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_onDemandMaxTotalPrice(t *testing.T) {
+func TestAccEC2SpotFleetRequest_associatePublicIPAddress(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -583,23 +549,27 @@ func TestAccEC2SpotFleetRequest_onDemandMaxTotalPrice(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_onDemandMaxTotalPrice(rName, publicKey, validUntil, "0.07"),
+				Config: testAccSpotFleetRequestConfig_associatePublicIPAddress(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "on_demand_max_total_price", "0.07"),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"associate_public_ip_address": acctest.CtTrue,
+					}),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_onDemandAllocationStrategy(t *testing.T) {
+func TestAccEC2SpotFleetRequest_associatePublicIPAddressSubnet(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -618,23 +588,30 @@ func TestAccEC2SpotFleetRequest_onDemandAllocationStrategy(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_onDemandAllocationStrategy(rName, publicKey, validUntil, "prioritized"),
+				Config: testAccSpotFleetRequestConfig_associatePublicIPAddressSubnet(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "on_demand_allocation_strategy", "prioritized"),
-				),
-			},
-			{
+					testAccCheckSpotFleetRequest_AssociatePublicIPAddress(&sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"associate_public_ip_address": acctest.CtTrue,
+						"vpc_security_group_ids.#":    acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.subnet_id", "aws_subnet.test", names.AttrID),
+				),
+			},
+			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_instanceInterruptionBehavior(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -653,24 +630,25 @@ func TestAccEC2SpotFleetRequest_instanceInterruptionBehavior(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplate(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
 					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "instance_interruption_behaviour", "stop"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_fleetType(t *testing.T) {
+func TestAccEC2SpotFleetRequest_LaunchTemplate_specificationIDOrName(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -689,24 +667,38 @@ func TestAccEC2SpotFleetRequest_fleetType(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_type(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateSpecification(rName, publicKey, validUntil, `id      = aws_launch_template.test.id
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version`),
+				ExpectError: regexache.MustCompile(`exactly one of "launch_template_config.launch_template_specification.id" or\s+"launch_template_config.launch_template_specification.name" must be specified`),
+			},
+			{
+				Config:      testAccSpotFleetRequestConfig_launchTemplateSpecification(rName, publicKey, validUntil, `version = aws_launch_template.test.latest_version`),
+				ExpectError: regexache.MustCompile(`exactly one of "launch_template_config.launch_template_specification.id" or\s+"launch_template_config.launch_template_specification.name" must be specified`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateSpecification(rName, publicKey, validUntil, `id      = aws_launch_template.test.id
+      version = aws_launch_template.test.latest_version`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "fleet_type", "request"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_template_config.*.launch_template_specification.0.id", "aws_launch_template.test", names.AttrID),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config: testAccSpotFleetRequestConfig_launchTemplateSpecification(rName, publicKey, validUntil, `name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_template_config.*.launch_template_specification.0.name", "aws_launch_template.test", names.AttrName),
+				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_iamInstanceProfileARN(t *testing.T) {
+func TestAccEC2SpotFleetRequest_LaunchTemplate_versionDefault(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -725,26 +717,27 @@ func TestAccEC2SpotFleetRequest_iamInstanceProfileARN(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_iamInstanceProfileARN(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateSpecification(rName, publicKey, validUntil, `id      = aws_launch_template.test.id
+      version = "$Default"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					testAccCheckSpotFleetRequest_IAMInstanceProfileARN(&sfr),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.launch_template_specification.*", map[string]string{
+						names.AttrVersion: "$Default",
+					}),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config: testAccSpotFleetRequestConfig_launchTemplateSpecification(rName, publicKey, validUntil, `id      = aws_launch_template.test.id
+      version = "$Default"`),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_changePriceForcesNewRequest(t *testing.T) {
+func TestAccEC2SpotFleetRequest_LaunchTemplate_multiple(t *testing.T) {
 	ctx := acctest.Context(t)
-	var before, after awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -761,37 +754,21 @@ func TestAccEC2SpotFleetRequest_changePriceForcesNewRequest(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
-			},
-			{
-				Config: testAccSpotFleetRequestConfig_changeBidPrice(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateMultiple(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
 					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
-					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct2),
 				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_updateTargetCapacity(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceTypeOverrides(t *testing.T) {
 	ctx := acctest.Context(t)
-	var before, after awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -808,41 +785,41 @@ func TestAccEC2SpotFleetRequest_updateTargetCapacity(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceTypeOverrides(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
 					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*", map[string]string{
+						"overrides.#": acctest.Ct2,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#": acctest.Ct0,
+						names.AttrInstanceType:    "t1.micro",
+						"weighted_capacity":       acctest.Ct2,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#": acctest.Ct0,
+						names.AttrInstanceType:    "m3.medium",
+						names.AttrPriority:        acctest.Ct1,
+						"spot_price":              "0.26",
+					}),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
-			},
-			{
-				Config: testAccSpotFleetRequestConfig_targetCapacity(rName, publicKey, validUntil),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
-					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct3),
-				),
-			},
-			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
-				),
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_updateExcessCapacityTerminationPolicy(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateOverridesSpotPriceNormalized(t *testing.T) {
 	ctx := acctest.Context(t)
-	var before, after awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -859,31 +836,33 @@ func TestAccEC2SpotFleetRequest_updateExcessCapacityTerminationPolicy(t *testing
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateOverridesSpotPrice(rName, publicKey, validUntil, "0.2600"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "Default"),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"spot_price": "0.26",
+					}),
 				),
 			},
+			{
+				Config:   testAccSpotFleetRequestConfig_launchTemplateOverridesSpotPrice(rName, publicKey, validUntil, "0.2600"),
+				PlanOnly: true,
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_launchTemplateOverridesSpotPrice(rName, publicKey, validUntil, "0.26"),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
-			},
-			{
-				Config: testAccSpotFleetRequestConfig_excessCapacityTermination(rName, publicKey, validUntil),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
-					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "NoTermination"),
-				),
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_lowestPriceAzOrSubnetInRegion(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateOverridesSpotPriceMigrate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -896,36 +875,37 @@ func TestAccEC2SpotFleetRequest_lowestPriceAzOrSubnetInRegion(t *testing.T) {
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		PreCheck:     func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.EC2ServiceID),
+		CheckDestroy: testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.54.1",
+					},
+				},
+				Config: testAccSpotFleetRequestConfig_launchTemplateOverridesSpotPrice(rName, publicKey, validUntil, "0.26"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccSpotFleetRequestConfig_launchTemplateOverridesSpotPrice(rName, publicKey, validUntil, "0.26"),
+				PlanOnly:                 true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_lowestPriceAzInGivenList(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceRequirementsOverrides(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
-	availabilityZonesDataSource := "data.aws_availability_zones.available"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -939,26 +919,39 @@ func TestAccEC2SpotFleetRequest_lowestPriceAzInGivenList(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_azs(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverrides(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
 					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
-					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.availability_zone", availabilityZonesDataSource, "names.0"),
-					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.availability_zone", availabilityZonesDataSource, "names.1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*", map[string]string{
+						"overrides.#": acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                        acctest.Ct1,
+						"instance_requirements.0.instance_generations.#": acctest.Ct1,
+						"instance_requirements.0.memory_mib.#":           acctest.Ct1,
+						"instance_requirements.0.memory_mib.0.max":       "50000",
+						"instance_requirements.0.memory_mib.0.min":       "500",
+						"instance_requirements.0.vcpu_count.#":           acctest.Ct1,
+						"instance_requirements.0.vcpu_count.0.max":       "8",
+						"instance_requirements.0.vcpu_count.0.min":       acctest.Ct1,
+						names.AttrInstanceType:                           "",
+					}),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_lowestPriceSubnetInGivenList(t *testing.T) {
+func TestAccEC2SpotFleetRequest_LaunchTemplateInstanceRequirements_accelerators(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -977,31 +970,87 @@ func TestAccEC2SpotFleetRequest_lowestPriceSubnetInGivenList(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_subnet(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`accelerator_count {
+          min = 1
+          max = 4
+        }
+        accelerator_manufacturers = ["nvidia"]
+        accelerator_names         = ["t4", "a10g"]
+        accelerator_total_memory_mib {
+          min = 16384
+        }
+        accelerator_types = ["gpu"]
+        memory_mib {
+          min = 500
+        }
+        vcpu_count {
+          min = 1
+        }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                                    acctest.Ct1,
+						"instance_requirements.0.accelerator_count.#":                acctest.Ct1,
+						"instance_requirements.0.accelerator_count.0.max":            acctest.Ct4,
+						"instance_requirements.0.accelerator_count.0.min":            acctest.Ct1,
+						"instance_requirements.0.accelerator_manufacturers.#":        acctest.Ct1,
+						"instance_requirements.0.accelerator_manufacturers.0":        "nvidia",
+						"instance_requirements.0.accelerator_names.#":                acctest.Ct2,
+						"instance_requirements.0.accelerator_total_memory_mib.#":     acctest.Ct1,
+						"instance_requirements.0.accelerator_total_memory_mib.0.max": acctest.Ct0,
+						"instance_requirements.0.accelerator_total_memory_mib.0.min": "16384",
+						"instance_requirements.0.accelerator_types.#":                acctest.Ct1,
+						"instance_requirements.0.accelerator_types.0":                "gpu",
+					}),
 				),
 			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`accelerator_count {
+          min = 1
+          max = 4
+        }
+        accelerator_manufacturers = ["nvidia"]
+        accelerator_names         = ["t4", "a10g"]
+        accelerator_total_memory_mib {
+          min = 16384
+        }
+        accelerator_types = ["gpu"]
+        memory_mib {
+          min = 500
+        }
+        vcpu_count {
+          min = 1
+        }`),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_multipleInstanceTypesInSameAz(t *testing.T) {
+func TestAccEC2SpotFleetRequest_LaunchTemplateInstanceRequirements_acceleratorCountExcluded(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
-	instanceTypeDataSource := "data.aws_ec2_instance_type_offering.available"
-	availabilityZonesDataSource := "data.aws_availability_zones.available"
+	instanceRequirements := `accelerator_count {
+          max = 0
+        }
+        memory_mib {
+          min = 500
+        }
+        vcpu_count {
+          min = 1
+        }`
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -1015,29 +1064,27 @@ func TestAccEC2SpotFleetRequest_multipleInstanceTypesInSameAz(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_multipleInstanceTypesinSameAZ(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil, instanceRequirements),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
-					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.availability_zone", availabilityZonesDataSource, "names.0"),
-					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.instance_type", instanceTypeDataSource, names.AttrInstanceType),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
-						names.AttrInstanceType: "m3.large",
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                         acctest.Ct1,
+						"instance_requirements.0.accelerator_count.#":     acctest.Ct1,
+						"instance_requirements.0.accelerator_count.0.max": acctest.Ct0,
+						"instance_requirements.0.accelerator_count.0.min": acctest.Ct0,
 					}),
+					testAccCheckSpotFleetRequestInstanceRequirementsAcceleratorCountMax(&sfr, 0),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config:   testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil, instanceRequirements),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_multipleInstanceTypesInSameSubnet(t *testing.T) {
+func TestAccEC2SpotFleetRequest_LaunchTemplateInstanceRequirements_allowedInstanceTypesWildcard(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1056,30 +1103,53 @@ func TestAccEC2SpotFleetRequest_multipleInstanceTypesInSameSubnet(t *testing.T)
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_multipleInstanceTypesinSameSubnet(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`allowed_instance_types = ["m5..large"]
+        memory_mib {
+          min = 500
+        }
+        vcpu_count {
+          min = 1
+        }`),
+				ExpectError: regexache.MustCompile(`must be an instance type or a wildcard pattern`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`allowed_instance_types = ["m5.*", "c5*.*"]
+        memory_mib {
+          min = 500
+        }
+        vcpu_count {
+          min = 1
+        }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                          acctest.Ct1,
+						"instance_requirements.0.allowed_instance_types.#": acctest.Ct2,
+					}),
 				),
 			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
-			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_overridingSpotPrice(t *testing.T) {
+func TestAccEC2SpotFleetRequest_LaunchTemplateInstanceRequirements_baselineEBSBandwidthMbps(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
-	instanceTypeDataSourceName := "data.aws_ec2_instance_type_offering.available"
+	instanceRequirements := `baseline_ebs_bandwidth_mbps {
+          min = 10
+          max = 20000
+        }
+        memory_mib {
+          min = 500
+        }
+        vcpu_count {
+          min = 1
+        }`
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -1093,30 +1163,32 @@ func TestAccEC2SpotFleetRequest_overridingSpotPrice(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_overridingPrice(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil, instanceRequirements),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
-						"spot_price":           "0.07",
-						names.AttrInstanceType: "m3.large",
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                                   acctest.Ct1,
+						"instance_requirements.0.baseline_ebs_bandwidth_mbps.#":     acctest.Ct1,
+						"instance_requirements.0.baseline_ebs_bandwidth_mbps.0.min": "10",
+						"instance_requirements.0.baseline_ebs_bandwidth_mbps.0.max": "20000",
 					}),
-					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.instance_type", instanceTypeDataSourceName, names.AttrInstanceType),
 				),
 			},
+			{
+				Config:   testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil, instanceRequirements),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_withoutSpotPrice(t *testing.T) {
+func TestAccEC2SpotFleetRequest_LaunchTemplateInstanceRequirements_requireHibernateSupport(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1135,29 +1207,64 @@ func TestAccEC2SpotFleetRequest_withoutSpotPrice(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_noPrice(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`memory_mib {
+          min = 500
+        }
+        require_hibernate_support = true
+        vcpu_count {
+          min = 1
+        }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                           acctest.Ct1,
+						"instance_requirements.0.require_hibernate_support": acctest.CtTrue,
+					}),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event"},
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`memory_mib {
+          min = 500
+        }
+        require_hibernate_support = false
+        vcpu_count {
+          min = 1
+        }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                           acctest.Ct1,
+						"instance_requirements.0.require_hibernate_support": acctest.CtFalse,
+					}),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`memory_mib {
+          min = 500
+        }
+        require_hibernate_support = false
+        vcpu_count {
+          min = 1
+        }`),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_diversifiedAllocation(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchSpecMonitoringWithLaunchTemplate(t *testing.T) {
 	ctx := acctest.Context(t)
-	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
-	resourceName := "aws_spot_fleet_request.test"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -1171,30 +1278,20 @@ func TestAccEC2SpotFleetRequest_diversifiedAllocation(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_diversifiedAllocation(rName, publicKey, validUntil),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct3),
-					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "diversified"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config:      testAccSpotFleetRequestConfig_launchSpecMonitoringWithLaunchTemplate(rName, publicKey, validUntil),
+				ExpectError: regexache.MustCompile(`only one of .launch_specification,launch_template_config. can be specified`),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_multipleInstancePools(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateInstanceRequirementsOverridesPublicIP(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
+	launchTemplateResourceName := "aws_launch_template.test"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -1208,26 +1305,27 @@ func TestAccEC2SpotFleetRequest_multipleInstancePools(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_multipleInstancePools(rName, publicKey, validUntil),
+				Config:      testAccSpotFleetRequestConfig_launchSpecPublicIPWithLaunchTemplate(rName, publicKey, validUntil),
+				ExpectError: regexache.MustCompile(`only one of .launch_specification,launch_template_config. can be specified`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverridesPublicIP(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct3),
-					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "lowestPrice"),
-					resource.TestCheckResourceAttr(resourceName, "instance_pools_to_use_count", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#": acctest.Ct1,
+					}),
+					resource.TestCheckResourceAttr(launchTemplateResourceName, "network_interfaces.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(launchTemplateResourceName, "network_interfaces.0.associate_public_ip_address", acctest.CtTrue),
 				),
 			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
-			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_withWeightedCapacity(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateInstancePoolsToUseCount(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1239,20 +1337,6 @@ func TestAccEC2SpotFleetRequest_withWeightedCapacity(t *testing.T) {
 		t.Fatalf("error generating random SSH key: %s", err)
 	}
 
-	fulfillSleep := func() resource.TestCheckFunc {
-		// sleep so that EC2 can fuflill the request. We do this to guard against a
-		// regression and possible leak where we'll destroy the request and the
-		// associated IAM role before anything is actually provisioned and running,
-		// thus leaking when those newly started instances are attempted to be
-		// destroyed
-		// See https://github.com/hashicorp/terraform/pull/8938
-		return func(s *terraform.State) error {
-			log.Print("[DEBUG] Test: Sleep to allow EC2 to actually begin fulfilling TestAccEC2SpotFleetRequest_withWeightedCapacity request")
-			time.Sleep(1 * time.Minute)
-			return nil
-		}
-	}
-
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
@@ -1260,35 +1344,26 @@ func TestAccEC2SpotFleetRequest_withWeightedCapacity(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_weightedCapacity(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstancePoolsToUseCount(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					fulfillSleep(),
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
-						"weighted_capacity":    acctest.Ct3,
-						names.AttrInstanceType: "r3.large",
-					}),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
-						"weighted_capacity":    "6",
-						names.AttrInstanceType: "m3.large",
-					}),
+					resource.TestCheckResourceAttr(resourceName, "instance_pools_to_use_count", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_withEBSDisk(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateInstanceRequirementsMemoryGiBPerVCPU(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -1305,25 +1380,34 @@ func TestAccEC2SpotFleetRequest_withEBSDisk(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_ebs(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsMemoryGiBPerVCPU(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
-					testAccCheckSpotFleetRequest_EBSAttributes(&config),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                           acctest.Ct1,
+						"instance_requirements.0.memory_gib_per_vcpu.#":     acctest.Ct1,
+						"instance_requirements.0.memory_gib_per_vcpu.0.min": acctest.Ct4,
+						"instance_requirements.0.memory_gib_per_vcpu.0.max": "8",
+					}),
 				),
 			},
+			{
+				Config:   testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsMemoryGiBPerVCPU(rName, publicKey, validUntil),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_LaunchSpecificationEBSBlockDevice_kmsKeyID(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateInstanceRequirementsNetworkBandwidthGbps(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -1340,27 +1424,36 @@ func TestAccEC2SpotFleetRequest_LaunchSpecificationEBSBlockDevice_kmsKeyID(t *te
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchSpecificationEBSBlockDeviceKMSKeyID(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsNetworkBandwidthGbps(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					testAccCheckSpotFleetRequestInstanceRequirementsNetworkBandwidthGbps(&sfr, 1.5, 40),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                              acctest.Ct1,
+						"instance_requirements.0.network_bandwidth_gbps.#":     acctest.Ct1,
+						"instance_requirements.0.network_bandwidth_gbps.0.min": "1.5",
+						"instance_requirements.0.network_bandwidth_gbps.0.max": "40",
+					}),
 				),
 			},
+			{
+				Config:   testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsNetworkBandwidthGbps(rName, publicKey, validUntil),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_LaunchSpecificationRootBlockDevice_kmsKeyID(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateInstanceRequirementsOverridesSpotPrice(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
-	resourceName := "aws_spot_fleet_request.test"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
 	if err != nil {
@@ -1374,25 +1467,18 @@ func TestAccEC2SpotFleetRequest_LaunchSpecificationRootBlockDevice_kmsKeyID(t *t
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceKMSKeyID(rName, publicKey, validUntil),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config:      testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverridesSpotPrice(rName, publicKey, validUntil),
+				ExpectError: regexache.MustCompile(`"launch_template_config.overrides.spot_price" cannot be specified with "launch_template_config.overrides.instance_requirements"`),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_LaunchSpecification_ebsBlockDeviceGP3(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateInstanceRequirementsLocalStorage(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
@@ -1407,32 +1493,37 @@ func TestAccEC2SpotFleetRequest_LaunchSpecification_ebsBlockDeviceGP3(t *testing
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchSpecificationEBSBlockDeviceGP3(rName, publicKey),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsLocalStorage(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*.ebs_block_device.*", map[string]string{
-						names.AttrDeviceName: "/dev/xvdcz",
-						names.AttrIOPS:       "4000",
-						names.AttrThroughput: "500",
-						names.AttrVolumeSize: "15",
-						names.AttrVolumeType: "gp3",
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                       acctest.Ct1,
+						"instance_requirements.0.local_storage":         "required",
+						"instance_requirements.0.local_storage_types.#": acctest.Ct2,
 					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.*.overrides.*.instance_requirements.0.local_storage_types.*", "hdd"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "launch_template_config.*.overrides.*.instance_requirements.0.local_storage_types.*", "ssd"),
 				),
 			},
+			{
+				Config:   testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsLocalStorage(rName, publicKey, validUntil),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_LaunchSpecification_rootBlockDeviceGP3(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateInstanceRequirementsLocalStorageExcludedTotalLocalStorageGB(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
 
 	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
@@ -1447,30 +1538,48 @@ func TestAccEC2SpotFleetRequest_LaunchSpecification_rootBlockDeviceGP3(t *testin
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceGP3(rName, publicKey),
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`local_storage = "excluded"
+        memory_mib {
+          min = 500
+        }
+        total_local_storage_gb {
+          min = 10
+        }
+        vcpu_count {
+          min = 1
+        }`),
+				ExpectError: regexache.MustCompile(`"launch_template_config.overrides.instance_requirements.total_local_storage_gb"\s+cannot be specified when "local_storage" is "excluded"`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil,
+					`local_storage = "included"
+        memory_mib {
+          min = 500
+        }
+        total_local_storage_gb {
+          min = 10
+        }
+        vcpu_count {
+          min = 1
+        }`),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*.root_block_device.*", map[string]string{
-						names.AttrIOPS:       "4000",
-						names.AttrThroughput: "500",
-						names.AttrVolumeSize: "15",
-						names.AttrVolumeType: "gp3",
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.#":                              acctest.Ct1,
+						"instance_requirements.0.local_storage":                "included",
+						"instance_requirements.0.total_local_storage_gb.#":     acctest.Ct1,
+						"instance_requirements.0.total_local_storage_gb.0.min": "10",
 					}),
 				),
 			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
-			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_withTags(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateOverridesMixedPriority(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -1487,28 +1596,30 @@ func TestAccEC2SpotFleetRequest_withTags(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_tags(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateOverridesMixedPriority(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
-					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
-						acctest.CtTagsPercent: acctest.Ct3,
-						"tags.First":          "TfAccTest",
-						"tags.Second":         "Terraform",
-						"tags.Name":           rName,
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.overrides.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						names.AttrInstanceType: "t3.micro",
+						names.AttrPriority:     acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						names.AttrInstanceType: "t3.small",
+						names.AttrPriority:     acctest.Ct2,
 					}),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config:   testAccSpotFleetRequestConfig_launchTemplateOverridesMixedPriority(rName, publicKey, validUntil),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_placementTenancyAndGroup(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateOverridesPriorityFloat(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1527,26 +1638,31 @@ func TestAccEC2SpotFleetRequest_placementTenancyAndGroup(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_tenancyGroup(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplateOverridesPriorityFloat(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					testAccCheckSpotFleetRequest_PlacementAttributes(&sfr, rName),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.overrides.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						names.AttrInstanceType: "t3.micro",
+						names.AttrPriority:     "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						names.AttrInstanceType: "t3.small",
+						names.AttrPriority:     "10.5",
+					}),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config:   testAccSpotFleetRequestConfig_launchTemplateOverridesPriorityFloat(rName, publicKey, validUntil),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_withELBs(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchTemplateToLaunchSpec(t *testing.T) {
 	ctx := acctest.Context(t)
-	var sfr awstypes.SpotFleetRequestConfig
+	var before, after awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -1563,27 +1679,37 @@ func TestAccEC2SpotFleetRequest_withELBs(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_elbs(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_launchTemplate(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
 					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "load_balancers.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_withTargetGroups(t *testing.T) {
+func TestAccEC2SpotFleetRequest_launchSpecToLaunchTemplate(t *testing.T) {
 	ctx := acctest.Context(t)
-	var sfr awstypes.SpotFleetRequestConfig
+	var before, after awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -1600,25 +1726,29 @@ func TestAccEC2SpotFleetRequest_withTargetGroups(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_targetGroups(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
 					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
 					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "target_group_arns.#", acctest.Ct1),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config: testAccSpotFleetRequestConfig_launchTemplate(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", acctest.Ct1),
+					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_Zero_capacity(t *testing.T) {
+func TestAccEC2SpotFleetRequest_onDemandTargetCapacity(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1637,37 +1767,37 @@ func TestAccEC2SpotFleetRequest_Zero_capacity(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_zeroCapacity(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil, 0),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_target_capacity", acctest.Ct0),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 			{
-				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil, 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_target_capacity", acctest.Ct1),
 				),
 			},
 			{
-				Config: testAccSpotFleetRequestConfig_zeroCapacity(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil, 0),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_target_capacity", acctest.Ct0),
 				),
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_capacityRebalance(t *testing.T) {
+func TestAccEC2SpotFleetRequest_onDemandMaxTotalPrice(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1686,25 +1816,25 @@ func TestAccEC2SpotFleetRequest_capacityRebalance(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_capacityRebalance(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_onDemandMaxTotalPrice(rName, publicKey, validUntil, "0.07"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "spot_maintenance_strategies.0.capacity_rebalance.0.replacement_strategy", "launch"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_max_total_price", "0.07"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_instanceStoreAMI(t *testing.T) {
+func TestAccEC2SpotFleetRequest_onDemandMaxTotalPriceEquivalent(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config awstypes.SpotFleetRequestConfig
+	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 	resourceName := "aws_spot_fleet_request.test"
@@ -1721,26 +1851,29 @@ func TestAccEC2SpotFleetRequest_instanceStoreAMI(t *testing.T) {
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_launchSpecificationInstanceStoreAMI(rName, publicKey, validUntil),
+				Config:      testAccSpotFleetRequestConfig_onDemandMaxTotalPrice(rName, publicKey, validUntil, "ten"),
+				ExpectError: regexache.MustCompile(`must be a decimal number`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_onDemandMaxTotalPrice(rName, publicKey, validUntil, "10"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.0.ebs_block_device.#", acctest.Ct0),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.0.ebs_optimized", acctest.CtFalse),
-					resource.TestCheckResourceAttr(resourceName, "launch_specification.0.root_block_device.#", acctest.Ct0),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_max_total_price", "10"),
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+				Config:   testAccSpotFleetRequestConfig_onDemandMaxTotalPrice(rName, publicKey, validUntil, "10.0"),
+				PlanOnly: true,
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_onDemandMaxTotalPrice(rName, publicKey, validUntil, "10.00"),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccEC2SpotFleetRequest_noTerminateInstancesWithExpiration(t *testing.T) {
+func TestAccEC2SpotFleetRequest_onDemandAllocationStrategy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sfr awstypes.SpotFleetRequestConfig
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1759,267 +1892,4794 @@ func TestAccEC2SpotFleetRequest_noTerminateInstancesWithExpiration(t *testing.T)
 		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSpotFleetRequestConfig_noTerminateInstancesExpiration(rName, publicKey, validUntil),
+				Config: testAccSpotFleetRequestConfig_onDemandAllocationStrategy(rName, publicKey, validUntil, "prioritized"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
-					resource.TestCheckResourceAttr(resourceName, "terminate_instances_on_delete", acctest.CtTrue),
-					resource.TestCheckResourceAttr(resourceName, "terminate_instances_with_expiration", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_allocation_strategy", "prioritized"),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"terminate_instances_on_delete", "wait_for_fulfillment"},
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
 			},
 		},
 	})
 }
 
-func testAccCheckSpotFleetRequestRecreatedConfig(t *testing.T,
-	before, after *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		if before.SpotFleetRequestId == after.SpotFleetRequestId {
-			t.Fatalf("Expected change of Spot Fleet Request IDs, but both were %v", before.SpotFleetRequestId)
-		}
-		return nil
-	}
-}
+func TestAccEC2SpotFleetRequest_instanceInterruptionBehavior(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
 
-func testAccCheckSpotFleetRequestExists(ctx context.Context, n string, v *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s", n)
-		}
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
 
-		if rs.Primary.ID == "" {
-			return errors.New("No EC2 Spot Fleet Request ID is set")
-		}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "instance_interruption_behaviour", "stop"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+func TestAccEC2SpotFleetRequest_fleetType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
 
-		output, err := tfec2.FindSpotFleetRequestByID(ctx, conn, rs.Primary.ID)
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
 
-		if err != nil {
-			return err
-		}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_type(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", "request"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
 
-		*v = *output
+func TestAccEC2SpotFleetRequest_iamInstanceProfileARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
 
-		return nil
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
 	}
-}
-
-func testAccCheckSpotFleetRequestDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
 
-		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_spot_fleet_request" {
-				continue
-			}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_iamInstanceProfileARN(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					testAccCheckSpotFleetRequest_IAMInstanceProfileARN(&sfr),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
 
-			_, err := tfec2.FindSpotFleetRequestByID(ctx, conn, rs.Primary.ID)
+func TestAccEC2SpotFleetRequest_iamInstanceProfileARNOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
 
-			if tfresource.NotFound(err) {
-				continue
-			}
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
 
-			if err != nil {
-				return err
-			}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_iamInstanceProfileARN(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.iam_instance_profile_arn", "aws_iam_instance_profile.test-iam-instance-profile1", names.AttrARN),
+				),
+			},
+			{
+				// The name of the instance profile returned alongside its ARN must not cause a diff.
+				Config: testAccSpotFleetRequestConfig_iamInstanceProfileARN(rName, publicKey, validUntil),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestNotRecreated(t, &before, &after),
+				),
+			},
+		},
+	})
+}
 
-			return fmt.Errorf("EC2 Spot Fleet Request %s still exists", rs.Primary.ID)
-		}
+func TestAccEC2SpotFleetRequest_validUntilPast(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(-1 * time.Hour).Format(time.RFC3339)
 
-		return nil
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
 	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"valid_until" \(.+\) must be in the future`),
+			},
+		},
+	})
 }
 
-func testAccCheckSpotFleetRequest_EBSAttributes(sfr *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		if len(sfr.SpotFleetRequestConfig.LaunchSpecifications) == 0 {
-			return errors.New("Missing launch specification")
-		}
+func TestAccEC2SpotFleetRequest_LaunchSpecification_amiInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 
-		spec := sfr.SpotFleetRequestConfig.LaunchSpecifications[0]
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
 
-		ebs := spec.BlockDeviceMappings
-		if len(ebs) < 2 {
-			return fmt.Errorf("Expected %d block device mappings, got %d", 2, len(ebs))
-		}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_launchSpecificationAMI(rName, publicKey, validUntil, "ami-xxx"),
+				ExpectError: regexache.MustCompile(`must be a valid AMI ID`),
+			},
+			{
+				Config:      testAccSpotFleetRequestConfig_launchSpecificationAMI(rName, publicKey, validUntil, "AMI-12345678"),
+				ExpectError: regexache.MustCompile(`must be a valid AMI ID`),
+			},
+		},
+	})
+}
 
-		if *ebs[0].DeviceName != "/dev/xvda" {
-			return fmt.Errorf("Expected device 0's name to be %s, got %s", "/dev/xvda", *ebs[0].DeviceName)
-		}
-		if *ebs[1].DeviceName != "/dev/xvdcz" {
-			return fmt.Errorf("Expected device 1's name to be %s, got %s", "/dev/xvdcz", *ebs[1].DeviceName)
-		}
+func TestAccEC2SpotFleetRequest_iamInstanceProfileNameAndARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 
-		return nil
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
 	}
-}
 
-func testAccCheckSpotFleetRequest_PlacementAttributes(
-	sfr *awstypes.SpotFleetRequestConfig, rName string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_iamInstanceProfileNameAndARN(rName, publicKey, validUntil),
+				ExpectError: regexache.MustCompile(`only one of "launch_specification.iam_instance_profile" or`),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_userDataGzip(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_userDataGzip(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+				),
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_userDataGzip(rName, publicKey, validUntil),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_clientTokenUnique(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr1, sfr2 awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName1 := "aws_spot_fleet_request.test.0"
+	resourceName2 := "aws_spot_fleet_request.test.1"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_count(rName, publicKey, validUntil, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName1, &sfr1),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName2, &sfr2),
+					resource.TestCheckResourceAttrSet(resourceName1, "client_token"),
+					resource.TestCheckResourceAttrSet(resourceName2, "client_token"),
+					func(*terraform.State) error {
+						if v1, v2 := aws.ToString(sfr1.SpotFleetRequestConfig.ClientToken), aws.ToString(sfr2.SpotFleetRequestConfig.ClientToken); v1 == v2 {
+							return fmt.Errorf("EC2 Spot Fleet Requests (%s, %s) have the same client token: %s", aws.ToString(sfr1.SpotFleetRequestId), aws.ToString(sfr2.SpotFleetRequestId), v1)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_changePriceForcesNewRequest(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_changeBidPrice(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
+					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_updateTargetCapacityWaitForScaleIn(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_targetCapacityWaitForScaleIn(rName, publicKey, validUntil, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "fulfilled_capacity", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_scale_in", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment", "wait_for_scale_in"},
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_targetCapacityWaitForScaleIn(rName, publicKey, validUntil, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "fulfilled_capacity", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_updateTargetCapacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_targetCapacity(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_updateInstanceInterruptionBehavior(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_instanceInterruptionBehavior(rName, publicKey, validUntil, "terminate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "instance_interruption_behaviour", "terminate"),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_instanceInterruptionBehavior(rName, publicKey, validUntil, "stop"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						// ModifySpotFleetRequest cannot change the interruption behavior. The plan's replacement
+						// is the only hint given, as CustomizeDiff cannot return warning diagnostics.
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "instance_interruption_behaviour", "stop"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_updateReplaceUnhealthyInstances(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_replaceUnhealthyInstances(rName, publicKey, validUntil, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "replace_unhealthy_instances", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_replaceUnhealthyInstances(rName, publicKey, validUntil, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						// ModifySpotFleetRequest cannot change unhealthy instance replacement.
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "replace_unhealthy_instances", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_updateFleetTypeCreateBeforeDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_fleetTypeCreateBeforeDestroy(rName, publicKey, validUntil, "maintain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", "maintain"),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_fleetTypeCreateBeforeDestroy(rName, publicKey, validUntil, "request"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+					testAccCheckSpotFleetRequestCancelled(ctx, &before),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", "request"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_updateExcessCapacityTerminationPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "Default"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_excessCapacityTermination(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "NoTermination"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_toggleExcessCapacityTerminationPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_excessCapacityTerminationPolicy(rName, publicKey, validUntil, "Default"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "Default"),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_excessCapacityTerminationPolicy(rName, publicKey, validUntil, "NoTermination"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "NoTermination"),
+				),
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_excessCapacityTerminationPolicy(rName, publicKey, validUntil, "NoTermination"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_excessCapacityTerminationPolicy(rName, publicKey, validUntil, "Default"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "excess_capacity_termination_policy", "Default"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_lowestPriceAzOrSubnetInRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_lowestPriceAzInGivenList(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+	availabilityZonesDataSource := "data.aws_availability_zones.available"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_azs(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.availability_zone", availabilityZonesDataSource, "names.0"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.availability_zone", availabilityZonesDataSource, "names.1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_lowestPriceSubnetInGivenList(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_subnet(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_multipleInstanceTypesInSameAz(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+	instanceTypeDataSource := "data.aws_ec2_instance_type_offering.available"
+	availabilityZonesDataSource := "data.aws_availability_zones.available"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_multipleInstanceTypesinSameAZ(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.availability_zone", availabilityZonesDataSource, "names.0"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.instance_type", instanceTypeDataSource, names.AttrInstanceType),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						names.AttrInstanceType: "m3.large",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_multipleInstanceTypesInSameSubnet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_multipleInstanceTypesinSameSubnet(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_overridingSpotPrice(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+	instanceTypeDataSourceName := "data.aws_ec2_instance_type_offering.available"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_overridingPrice(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "spot_price", "0.07"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"spot_price":           "0.07",
+						names.AttrInstanceType: "m3.large",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "launch_specification.*.instance_type", instanceTypeDataSourceName, names.AttrInstanceType),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_withoutSpotPrice(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_noPrice(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_diversifiedAllocation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_diversifiedAllocation(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "diversified"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_allocationStrategyCaseInsensitive(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_allocationStrategies(rName, publicKey, validUntil, "CapacityOptimized", "Prioritized"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "capacityOptimized"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_allocation_strategy", "prioritized"),
+				),
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_allocationStrategies(rName, publicKey, validUntil, "CapacityOptimized", "Prioritized"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_multipleInstancePools(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_multipleInstancePools(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "lowestPrice"),
+					resource.TestCheckResourceAttr(resourceName, "instance_pools_to_use_count", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_withWeightedCapacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	fulfillSleep := func() resource.TestCheckFunc {
+		// sleep so that EC2 can fuflill the request. We do this to guard against a
+		// regression and possible leak where we'll destroy the request and the
+		// associated IAM role before anything is actually provisioned and running,
+		// thus leaking when those newly started instances are attempted to be
+		// destroyed
+		// See https://github.com/hashicorp/terraform/pull/8938
+		return func(s *terraform.State) error {
+			log.Print("[DEBUG] Test: Sleep to allow EC2 to actually begin fulfilling TestAccEC2SpotFleetRequest_withWeightedCapacity request")
+			time.Sleep(1 * time.Minute)
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_weightedCapacity(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					fulfillSleep(),
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttrSet(resourceName, "fulfilled_capacity"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"weighted_capacity":    acctest.Ct3,
+						names.AttrInstanceType: "r3.large",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"weighted_capacity":    "6",
+						names.AttrInstanceType: "m3.large",
+					}),
+				),
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_weightedCapacity(rName, publicKey, validUntil),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_withWeightedCapacityFractional(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_weightedCapacityFractional(rName, publicKey, validUntil, "2,5"),
+				ExpectError: regexache.MustCompile(`must be a decimal number`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_weightedCapacityFractional(rName, publicKey, validUntil, "2.5"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"weighted_capacity": "2.5",
+					}),
+					// A target of 3 units is fulfilled by two instances weighted 2.5 each.
+					resource.TestCheckResourceAttrWith(resourceName, "fulfilled_capacity", func(value string) error {
+						if value != "5" {
+							return fmt.Errorf("fulfilled_capacity = %s, want 5", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_weightedCapacityFractional(rName, publicKey, validUntil, "2.50"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_withEBSDisk(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_ebs(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					testAccCheckSpotFleetRequest_EBSAttributes(&config),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecificationEphemeralBlockDevice_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_launchSpecificationEphemeralBlockDeviceArguments(rName, publicKey, validUntil, `no_device = false`),
+				ExpectError: regexache.MustCompile(`"launch_specification.ephemeral_block_device.virtual_name" must be specified`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationEphemeralBlockDeviceArguments(rName, publicKey, validUntil, `no_device    = true
+      virtual_name = "ephemeral0"`),
+				ExpectError: regexache.MustCompile(`only one of "launch_specification.ephemeral_block_device.virtual_name" or "launch_specification.ephemeral_block_device.no_device" can be specified`),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecificationEphemeralBlockDevice_noDevice(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationEphemeralBlockDeviceNoDevice(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*.ephemeral_block_device.*", map[string]string{
+						names.AttrDeviceName: "/dev/sdb",
+						"no_device":          acctest.CtTrue,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*.ephemeral_block_device.*", map[string]string{
+						names.AttrDeviceName:  "/dev/sdc",
+						names.AttrVirtualName: "ephemeral0",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecificationEBSBlockDevice_kmsKeyID(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationEBSBlockDeviceKMSKeyID(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecificationEBSBlockDevice_validateSnapshotVolumeSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_launchSpecificationEBSBlockDeviceSnapshot(rName, publicKey, validUntil, 1),
+				ExpectError: regexache.MustCompile(`"volume_size" \(1 GiB\) must be at least the size of snapshot`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationEBSBlockDeviceSnapshot(rName, publicKey, validUntil, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "validate_ebs_snapshot_volume_size", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "validate_ebs_snapshot_volume_size"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_validateEBSSnapshotVolumeSizeWithLaunchTemplate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_validateEBSSnapshotVolumeSizeWithLaunchTemplate(rName, publicKey, validUntil),
+				ExpectError: regexache.MustCompile(`"validate_ebs_snapshot_volume_size" can only be set with\s+"launch_specification"`),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecificationRootBlockDevice_kmsKeyID(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceKMSKeyID(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecificationRootBlockDevice_defaultKMSKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceDefaultKMSKey(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"root_block_device.#":                       acctest.Ct1,
+						"root_block_device.0.encrypted":             acctest.CtTrue,
+						"root_block_device.0.volume_type":           "gp2",
+						"root_block_device.0.volume_size":           "10",
+						"root_block_device.0.delete_on_termination": acctest.CtTrue,
+					}),
+				),
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceDefaultKMSKey(rName, publicKey, validUntil),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecification_ebsBlockDeviceGP3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationEBSBlockDeviceGP3(rName, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*.ebs_block_device.*", map[string]string{
+						names.AttrDeviceName: "/dev/xvdcz",
+						names.AttrIOPS:       "4000",
+						names.AttrThroughput: "500",
+						names.AttrVolumeSize: "15",
+						names.AttrVolumeType: "gp3",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecification_rootBlockDeviceGP3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceGP3(rName, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*.root_block_device.*", map[string]string{
+						names.AttrIOPS:       "4000",
+						names.AttrThroughput: "500",
+						names.AttrVolumeSize: "15",
+						names.AttrVolumeType: "gp3",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecification_rootBlockDeviceGP3Defaults(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceGP3Defaults(rName, publicKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*.root_block_device.*", map[string]string{
+						names.AttrIOPS:       "3000",
+						names.AttrThroughput: "125",
+						names.AttrVolumeSize: "15",
+						names.AttrVolumeType: "gp3",
+					}),
+				),
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceGP3Defaults(rName, publicKey),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_LaunchSpecification_blockDevicePerformanceValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_launchSpecificationBlockDevicePerformance(rName, publicKey, "root_block_device", "gp3", 3000, 100),
+				ExpectError: regexache.MustCompile(`expected throughput to be in the range \(125 - 1000\)`),
+			},
+			{
+				Config:      testAccSpotFleetRequestConfig_launchSpecificationBlockDevicePerformance(rName, publicKey, "ebs_block_device", "gp3", 3000, 1001),
+				ExpectError: regexache.MustCompile(`expected throughput to be in the range \(125 - 1000\)`),
+			},
+			{
+				Config:      testAccSpotFleetRequestConfig_launchSpecificationBlockDevicePerformance(rName, publicKey, "root_block_device", "gp3", 1000, 125),
+				ExpectError: regexache.MustCompile(`"launch_specification.root_block_device.iops" must be at least 3000`),
+			},
+			{
+				Config:      testAccSpotFleetRequestConfig_launchSpecificationBlockDevicePerformance(rName, publicKey, "ebs_block_device", "io1", 99, 125),
+				ExpectError: regexache.MustCompile(`"launch_specification.ebs_block_device.iops" must be at least 100`),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_withTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_tags(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						acctest.CtTagsPercent: acctest.Ct3,
+						"tags.First":          "TfAccTest",
+						"tags.Second":         "Terraform",
+						"tags.Name":           rName,
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_placementTenancyAndGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_tenancyGroup(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					testAccCheckSpotFleetRequest_PlacementAttributes(&sfr, rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_placementTenancy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_placementTenancy(rName, publicKey, validUntil, "host"),
+				ExpectError: regexache.MustCompile(`host tenancy is not supported for Spot Instances`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_placementTenancy(rName, publicKey, validUntil, "default"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"placement_tenancy": "default",
+					}),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_placementTenancy(rName, publicKey, validUntil, "dedicated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestRecreatedConfig(t, &before, &after),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_specification.*", map[string]string{
+						"placement_tenancy": "dedicated",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_withELBs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_elbs(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "load_balancers.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_withTargetGroups(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_targetGroups(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target_group_arns.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_Zero_capacity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_zeroCapacity(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_zeroCapacity(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_pauseMaintainFleet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_maintainTargetCapacity(rName, publicKey, validUntil, -1),
+				ExpectError: regexache.MustCompile(`expected target_capacity to be at least \(0\), got -1`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_maintainTargetCapacity(rName, publicKey, validUntil, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", "maintain"),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_maintainTargetCapacity(rName, publicKey, validUntil, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_maintainTargetCapacity(rName, publicKey, validUntil, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_capacityRebalance(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_capacityRebalance(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "spot_maintenance_strategies.0.capacity_rebalance.0.replacement_strategy", "launch"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_capacityRebalanceInvalidType(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_capacityRebalanceInvalidType(rName, publicKey, validUntil),
+				ExpectError: regexache.MustCompile(`"spot_maintenance_strategies" can only be specified when "fleet_type" is "maintain"`),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_instanceStoreAMI(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchSpecificationInstanceStoreAMI(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.0.ebs_block_device.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.0.ebs_optimized", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "launch_specification.0.root_block_device.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_noTerminateInstancesWithExpiration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_noTerminateInstancesExpiration(rName, publicKey, validUntil),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "terminate_instances_on_delete", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "terminate_instances_with_expiration", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activity_status", "fulfilled_capacity", "last_event", "terminate_instances_on_delete", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotFleetRequest_terminateInstancesOnDelete(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sfr awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSpotFleetRequestConfig_terminateInstancesOnDelete(rName, publicKey, validUntil, "maybe"),
+				ExpectError: regexache.MustCompile(`cannot parse 'maybe' as boolean`),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_terminateInstancesOnDelete(rName, publicKey, validUntil, acctest.CtFalse),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &sfr),
+					resource.TestCheckResourceAttr(resourceName, "terminate_instances_on_delete", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "terminate_instances_with_expiration", acctest.CtTrue),
+				),
+			},
+			{
+				Config:   testAccSpotFleetRequestConfig_terminateInstancesOnDelete(rName, publicKey, validUntil, acctest.CtFalse),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestSpotFleetRequestTerminateInstancesOnDeleteValidation(t *testing.T) {
+	t.Parallel()
+
+	validateFunc := tfec2.ResourceSpotFleetRequest().SchemaMap()["terminate_instances_on_delete"].ValidateFunc
+
+	testCases := []struct {
+		value       string
+		expectError bool
+	}{
+		{value: ""},
+		{value: acctest.CtTrue},
+		{value: acctest.CtFalse},
+		{value: "maybe", expectError: true},
+	}
+
+	for _, testCase := range testCases {
+		_, errs := validateFunc(testCase.value, "terminate_instances_on_delete")
+
+		if got, want := len(errs) > 0, testCase.expectError; got != want {
+			t.Errorf("terminate_instances_on_delete = %q, got errors: %v, want errors: %t", testCase.value, errs, want)
+		}
+	}
+}
+
+func TestSpotFleetRequestAllocationStrategyEquivalence(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key         string
+		value       string
+		canonical   string
+		expectValid bool
+	}{
+		"allocation_strategy canonical": {
+			key:         "allocation_strategy",
+			value:       "lowestPrice",
+			canonical:   "lowestPrice",
+			expectValid: true,
+		},
+		"allocation_strategy case": {
+			key:         "allocation_strategy",
+			value:       "CapacityOptimized",
+			canonical:   "capacityOptimized",
+			expectValid: true,
+		},
+		"allocation_strategy hyphens": {
+			key:         "allocation_strategy",
+			value:       "lowest-price",
+			canonical:   "lowestPrice",
+			expectValid: true,
+		},
+		"allocation_strategy case and hyphens": {
+			key:         "allocation_strategy",
+			value:       "Price-Capacity-Optimized",
+			canonical:   "priceCapacityOptimized",
+			expectValid: true,
+		},
+		"allocation_strategy invalid": {
+			key:   "allocation_strategy",
+			value: "lowest_price",
+		},
+		"on_demand_allocation_strategy hyphens": {
+			key:         "on_demand_allocation_strategy",
+			value:       "lowest-price",
+			canonical:   "lowestPrice",
+			expectValid: true,
+		},
+		"on_demand_allocation_strategy invalid": {
+			key:   "on_demand_allocation_strategy",
+			value: "diversified",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tfec2.ResourceSpotFleetRequest()
+			config := terraformsdk.NewResourceConfigRaw(map[string]interface{}{
+				"iam_fleet_role": "arn:aws:iam::123456789012:role/test",
+				"launch_specification": []interface{}{
+					map[string]interface{}{
+						"ami":                  "ami-12345678",
+						names.AttrInstanceType: "t3.micro",
+					},
+				},
+				"target_capacity": 1,
+				testCase.key:      testCase.value,
+			})
+
+			diags := r.Validate(config)
+
+			if got, want := !diags.HasError(), testCase.expectValid; got != want {
+				t.Fatalf("valid: got %t, want %t (%v)", got, want, diags)
+			}
+
+			if !testCase.expectValid {
+				return
+			}
+
+			if !r.SchemaMap()[testCase.key].DiffSuppressFunc(testCase.key, testCase.canonical, testCase.value, nil) {
+				t.Errorf("expected no diff between %q and %q", testCase.canonical, testCase.value)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestLaunchSpecificationArgumentsWithLaunchTemplateValidation(t *testing.T) {
+	t.Parallel()
+
+	// Arguments that only apply to launch specifications are nested in "launch_specification",
+	// which ExactlyOneOf rejects alongside "launch_template_config".
+	testCases := map[string]map[string]interface{}{
+		"monitoring": {
+			"monitoring": true,
+		},
+		"ebs_optimized": {
+			"ebs_optimized": true,
+		},
+		"placement_tenancy": {
+			"placement_tenancy": "dedicated",
+		},
+	}
+
+	for name, launchSpecification := range testCases {
+		launchSpecification := launchSpecification
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			launchSpecification["ami"] = "ami-12345678"
+			launchSpecification[names.AttrInstanceType] = "t3.micro"
+			config := terraformsdk.NewResourceConfigRaw(map[string]interface{}{
+				"iam_fleet_role":       "arn:aws:iam::123456789012:role/test",
+				"launch_specification": []interface{}{launchSpecification},
+				"launch_template_config": []interface{}{
+					map[string]interface{}{
+						"launch_template_specification": []interface{}{
+							map[string]interface{}{
+								names.AttrName:    "test",
+								names.AttrVersion: "1",
+							},
+						},
+					},
+				},
+				"target_capacity": 1,
+			})
+
+			diags := tfec2.ResourceSpotFleetRequest().Validate(config)
+
+			if !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, d := range diags {
+				if regexache.MustCompile(`only one of .launch_specification,launch_template_config. can be specified`).MatchString(d.Detail) {
+					return
+				}
+			}
+
+			t.Errorf("expected ExactlyOneOf error, got %v", diags)
+		})
+	}
+}
+
+func TestSpotFleetRequestInstanceRequirementsNetworkBandwidthGbps(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"min and max": {
+			tfMap: map[string]interface{}{
+				names.AttrMin: 1.5,
+				names.AttrMax: 40.0,
+			},
+			expected: map[string]interface{}{
+				names.AttrMin: 1.5,
+				names.AttrMax: 40.0,
+			},
+		},
+		"min only": {
+			tfMap: map[string]interface{}{
+				names.AttrMin: 10.0,
+				names.AttrMax: 0.0,
+			},
+			expected: map[string]interface{}{
+				names.AttrMin: 10.0,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := tfec2.ExpandInstanceRequirements(map[string]interface{}{
+				"network_bandwidth_gbps": []interface{}{testCase.tfMap},
+			})
+
+			if apiObject.NetworkBandwidthGbps == nil {
+				t.Fatal("expected network bandwidth to be expanded")
+			}
+
+			v, ok := tfec2.FlattenInstanceRequirements(apiObject)["network_bandwidth_gbps"].([]interface{})
+			if !ok || len(v) != 1 {
+				t.Fatalf("expected network bandwidth to be flattened, got %v", v)
+			}
+
+			if got, want := v[0].(map[string]interface{}), testCase.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestTargetsHealthy(t *testing.T) {
+	t.Parallel()
+
+	targetHealthDescription := func(id string, state elasticloadbalancingv2types.TargetHealthStateEnum) elasticloadbalancingv2types.TargetHealthDescription {
+		return elasticloadbalancingv2types.TargetHealthDescription{
+			Target: &elasticloadbalancingv2types.TargetDescription{
+				Id: aws.String(id),
+			},
+			TargetHealth: &elasticloadbalancingv2types.TargetHealth{
+				State: state,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		instanceIDs []string
+		apiObjects  []elasticloadbalancingv2types.TargetHealthDescription
+		expected    bool
+	}{
+		"all healthy": {
+			instanceIDs: []string{"i-1", "i-2"},
+			apiObjects: []elasticloadbalancingv2types.TargetHealthDescription{
+				targetHealthDescription("i-1", elasticloadbalancingv2types.TargetHealthStateEnumHealthy),
+				targetHealthDescription("i-2", elasticloadbalancingv2types.TargetHealthStateEnumHealthy),
+				targetHealthDescription("i-3", elasticloadbalancingv2types.TargetHealthStateEnumUnhealthy),
+			},
+			expected: true,
+		},
+		"initial": {
+			instanceIDs: []string{"i-1", "i-2"},
+			apiObjects: []elasticloadbalancingv2types.TargetHealthDescription{
+				targetHealthDescription("i-1", elasticloadbalancingv2types.TargetHealthStateEnumHealthy),
+				targetHealthDescription("i-2", elasticloadbalancingv2types.TargetHealthStateEnumInitial),
+			},
+		},
+		"not registered": {
+			instanceIDs: []string{"i-1", "i-2"},
+			apiObjects: []elasticloadbalancingv2types.TargetHealthDescription{
+				targetHealthDescription("i-1", elasticloadbalancingv2types.TargetHealthStateEnumHealthy),
+			},
+		},
+		"no targets": {
+			instanceIDs: []string{"i-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfec2.SpotFleetRequestTargetsHealthy(testCase.instanceIDs, testCase.apiObjects), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRequestSpotFleetRetriesWithSameClientToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	input := &ec2.RequestSpotFleetInput{
+		SpotFleetRequestConfig: &awstypes.SpotFleetRequestConfigData{
+			ClientToken: aws.String("token"),
+		},
+	}
+
+	var clientTokens []string
+	f := func(_ context.Context, input *ec2.RequestSpotFleetInput, _ ...func(*ec2.Options)) (*ec2.RequestSpotFleetOutput, error) {
+		clientTokens = append(clientTokens, aws.ToString(input.SpotFleetRequestConfig.ClientToken))
+		if len(clientTokens) == 1 {
+			return nil, &smithy.GenericAPIError{Code: "InvalidSpotFleetRequestConfig", Message: "Parameter: SpotFleetRequestConfig.IamFleetRole is invalid."}
+		}
+
+		return &ec2.RequestSpotFleetOutput{SpotFleetRequestId: aws.String("sfr-12345678")}, nil
+	}
+
+	output, err := tfec2.RequestSpotFleet(ctx, f, input, time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.SpotFleetRequestId), "sfr-12345678"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	if got, want := clientTokens, []string{"token", "token"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestWaitSpotFleetRequestTargetsHealthy(t *testing.T) {
+	t.Parallel()
+
+	const targetGroupARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/test/1234567890123456" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		describeTargetHealth func() (*elasticloadbalancingv2.DescribeTargetHealthOutput, error)
+		expectedError        *regexp.Regexp
+	}{
+		"healthy": {
+			describeTargetHealth: func() (*elasticloadbalancingv2.DescribeTargetHealthOutput, error) {
+				return &elasticloadbalancingv2.DescribeTargetHealthOutput{
+					TargetHealthDescriptions: []elasticloadbalancingv2types.TargetHealthDescription{
+						{
+							Target:       &elasticloadbalancingv2types.TargetDescription{Id: aws.String("i-1")},
+							TargetHealth: &elasticloadbalancingv2types.TargetHealth{State: elasticloadbalancingv2types.TargetHealthStateEnumHealthy},
+						},
+					},
+				}, nil
+			},
+		},
+		"target health error": {
+			describeTargetHealth: func() (*elasticloadbalancingv2.DescribeTargetHealthOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "TargetGroupNotFound", Message: "One or more target groups not found"}
+			},
+			expectedError: regexache.MustCompile(`reading ELBv2 Target Group \(.+\) target health: .*TargetGroupNotFound`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			conn := ec2.New(ec2.Options{
+				APIOptions: []func(*middleware.Stack) error{
+					addStubResultMiddleware(func(interface{}) (interface{}, error) {
+						return &ec2.DescribeSpotFleetInstancesOutput{
+							ActiveInstances: []awstypes.ActiveInstance{{InstanceId: aws.String("i-1")}},
+						}, nil
+					}),
+				},
+			})
+			elbv2Conn := elasticloadbalancingv2.New(elasticloadbalancingv2.Options{
+				APIOptions: []func(*middleware.Stack) error{
+					addStubResultMiddleware(func(interface{}) (interface{}, error) {
+						return testCase.describeTargetHealth()
+					}),
+				},
+			})
+
+			err := tfec2.WaitSpotFleetRequestTargetsHealthy(ctx, conn, elbv2Conn, "sfr-12345678", []string{targetGroupARN}, time.Minute)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("got error %v, want %s", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+// addStubResultMiddleware short-circuits every operation of a client.
+// Each call is answered by f, which receives the operation input and returns its output or error.
+func addStubResultMiddleware(f func(params interface{}) (interface{}, error)) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(
+			middleware.InitializeMiddlewareFunc(
+				"Test: Stub Result",
+				func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+					result, err := f(in.Parameters)
+
+					return middleware.InitializeOutput{Result: result}, middleware.Metadata{}, err
+				},
+			),
+			middleware.Before,
+		)
+	}
+}
+
+func TestSpotFleetRequestIAMPropagationError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"nil": {},
+		"fleet role": {
+			err:      &smithy.GenericAPIError{Code: "InvalidSpotFleetRequestConfig", Message: "Parameter: SpotFleetRequestConfig.IamFleetRole is invalid."},
+			expected: true,
+		},
+		"fleet role not authorized": {
+			err:      &smithy.GenericAPIError{Code: "InvalidSpotFleetRequestConfig", Message: "The IamFleetRole is not authorized to perform this operation."},
+			expected: true,
+		},
+		"instance profile": {
+			err:      &smithy.GenericAPIError{Code: "InvalidParameterValue", Message: "Value (test) for parameter iamInstanceProfile.name is invalid. Invalid IAM Instance Profile name"},
+			expected: true,
+		},
+		"other configuration error": {
+			err: &smithy.GenericAPIError{Code: "InvalidSpotFleetRequestConfig", Message: "SpotMaintenanceStrategies option is only available with the spot fleet type maintain."},
+		},
+		"other error": {
+			err: errors.New("IamFleetRole"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfec2.IsSpotFleetRequestIAMPropagationError(testCase.err), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestInstanceRequirementsAcceleratorCount(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected *awstypes.AcceleratorCount
+	}{
+		"max zero": {
+			tfMap: map[string]interface{}{
+				names.AttrMin: 0,
+				names.AttrMax: 0,
+			},
+			expected: &awstypes.AcceleratorCount{
+				Max: aws.Int32(0),
+			},
+		},
+		"min only": {
+			tfMap: map[string]interface{}{
+				names.AttrMin: 1,
+				names.AttrMax: 0,
+			},
+			expected: &awstypes.AcceleratorCount{
+				Min: aws.Int32(1),
+			},
+		},
+		"min and max": {
+			tfMap: map[string]interface{}{
+				names.AttrMin: 1,
+				names.AttrMax: 4,
+			},
+			expected: &awstypes.AcceleratorCount{
+				Min: aws.Int32(1),
+				Max: aws.Int32(4),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := tfec2.ExpandInstanceRequirements(map[string]interface{}{
+				"accelerator_count": []interface{}{testCase.tfMap},
+			})
+
+			if got, want := apiObject.AcceleratorCount, testCase.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestValidSpotFleetInstanceRequirementsRanges(t *testing.T) {
+	t.Parallel()
+
+	rangeType := cty.List(cty.Object(map[string]cty.Type{names.AttrMin: cty.Number, names.AttrMax: cty.Number}))
+	instanceRequirements := func(k string, minimum, maximum cty.Value) cty.Value {
+		tfMap := map[string]cty.Value{}
+		for _, k := range []string{"accelerator_count", "accelerator_total_memory_mib", "memory_gib_per_vcpu", "network_bandwidth_gbps"} {
+			tfMap[k] = cty.NullVal(rangeType)
+		}
+		tfMap[k] = cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			names.AttrMin: minimum,
+			names.AttrMax: maximum,
+		})})
+
+		return cty.ListVal([]cty.Value{cty.ObjectVal(tfMap)})
+	}
+
+	testCases := map[string]struct {
+		instanceRequirements cty.Value
+		expectedError        *regexp.Regexp
+	}{
+		"null": {
+			instanceRequirements: cty.NullVal(cty.List(cty.EmptyObject)),
+		},
+		"min and max": {
+			instanceRequirements: instanceRequirements("network_bandwidth_gbps", cty.NumberFloatVal(1.5), cty.NumberFloatVal(40)),
+		},
+		"min only": {
+			instanceRequirements: instanceRequirements("memory_gib_per_vcpu", cty.NumberFloatVal(4), cty.NullVal(cty.Number)),
+		},
+		"max less than min": {
+			instanceRequirements: instanceRequirements("network_bandwidth_gbps", cty.NumberFloatVal(10), cty.NumberFloatVal(5)),
+			expectedError:        regexache.MustCompile(`"launch_template_config.overrides.instance_requirements.network_bandwidth_gbps.max" must not be less than "min"`),
+		},
+		"accelerator count max zero": {
+			instanceRequirements: instanceRequirements("accelerator_count", cty.NullVal(cty.Number), cty.NumberIntVal(0)),
+		},
+		"accelerator count max zero with min": {
+			instanceRequirements: instanceRequirements("accelerator_count", cty.NumberIntVal(1), cty.NumberIntVal(0)),
+			expectedError:        regexache.MustCompile(`"launch_template_config.overrides.instance_requirements.accelerator_count.max" must not be less than "min"`),
+		},
+		"accelerator count empty": {
+			instanceRequirements: instanceRequirements("accelerator_count", cty.NullVal(cty.Number), cty.NullVal(cty.Number)),
+			expectedError:        regexache.MustCompile(`"launch_template_config.overrides.instance_requirements.accelerator_count" must specify "min" or "max"`),
+		},
+		"unknown": {
+			instanceRequirements: instanceRequirements("accelerator_total_memory_mib", cty.NumberIntVal(10), cty.UnknownVal(cty.Number)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfec2.ValidSpotFleetInstanceRequirementsRanges(testCase.instanceRequirements)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("got error %v, want %s", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestInstanceRequirementsBaselineEBSBandwidthMbps(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"min and max": {
+			tfMap: map[string]interface{}{
+				names.AttrMin: 10,
+				names.AttrMax: 20000,
+			},
+			expected: map[string]interface{}{
+				names.AttrMin: int32(10),
+				names.AttrMax: int32(20000),
+			},
+		},
+		"min only": {
+			tfMap: map[string]interface{}{
+				names.AttrMin: 10,
+			},
+			expected: map[string]interface{}{
+				names.AttrMin: int32(10),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := tfec2.ExpandInstanceRequirements(map[string]interface{}{
+				"baseline_ebs_bandwidth_mbps": []interface{}{testCase.tfMap},
+			})
+
+			if apiObject.BaselineEbsBandwidthMbps == nil {
+				t.Fatal("expected baseline EBS bandwidth to be expanded")
+			}
+
+			v, ok := tfec2.FlattenInstanceRequirements(apiObject)["baseline_ebs_bandwidth_mbps"].([]interface{})
+			if !ok || len(v) != 1 {
+				t.Fatalf("expected baseline EBS bandwidth to be flattened, got %v", v)
+			}
+
+			if got, want := v[0].(map[string]interface{}), testCase.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestInstanceRequirementsRequireHibernateSupport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"true": {
+			tfMap: map[string]interface{}{
+				"require_hibernate_support": true,
+			},
+			expected: map[string]interface{}{
+				"require_hibernate_support": true,
+			},
+		},
+		"false": {
+			tfMap: map[string]interface{}{
+				"require_hibernate_support": false,
+			},
+			expected: map[string]interface{}{},
+		},
+		"omitted": {
+			tfMap:    map[string]interface{}{},
+			expected: map[string]interface{}{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.FlattenInstanceRequirements(tfec2.ExpandInstanceRequirements(testCase.tfMap))
+
+			if want := testCase.expected; !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestLaunchTemplateConfigsWithVersionDefault(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfList     []interface{}
+		configured []interface{}
+		expected   string
+	}{
+		"no prior configuration": {
+			tfList:   []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", "2")},
+			expected: "2",
+		},
+		"configured default by id": {
+			tfList:     []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", "2")},
+			configured: []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", tfec2.LaunchTemplateVersionDefault)},
+			expected:   tfec2.LaunchTemplateVersionDefault,
+		},
+		"configured default by name": {
+			tfList:     []interface{}{testSpotFleetRequestLaunchTemplateConfig("", "test", "2")},
+			configured: []interface{}{testSpotFleetRequestLaunchTemplateConfig("", "test", tfec2.LaunchTemplateVersionDefault)},
+			expected:   tfec2.LaunchTemplateVersionDefault,
+		},
+		"configured default for other launch template": {
+			tfList:     []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", "2")},
+			configured: []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-87654321", "", tfec2.LaunchTemplateVersionDefault)},
+			expected:   "2",
+		},
+		"configured numeric version": {
+			tfList:     []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", "2")},
+			configured: []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", "1")},
+			expected:   "2",
+		},
+		"non-numeric version": {
+			tfList:     []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", tfec2.LaunchTemplateVersionLatest)},
+			configured: []interface{}{testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", tfec2.LaunchTemplateVersionDefault)},
+			expected:   tfec2.LaunchTemplateVersionLatest,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tfList := tfec2.LaunchTemplateConfigsWithVersionDefault(testCase.tfList, testCase.configured)
+			got := tfList[0].(map[string]interface{})["launch_template_specification"].([]interface{})[0].(map[string]interface{})[names.AttrVersion]
+
+			if want := testCase.expected; got != want {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestLaunchTemplateVersionDefaultDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old          string
+		new          string
+		expectedDiff bool
+	}{
+		"resolved default": {
+			old:          "2",
+			new:          tfec2.LaunchTemplateVersionDefault,
+			expectedDiff: false,
+		},
+		"unchanged default": {
+			old:          tfec2.LaunchTemplateVersionDefault,
+			new:          tfec2.LaunchTemplateVersionDefault,
+			expectedDiff: false,
+		},
+		"numeric version": {
+			old:          "1",
+			new:          "2",
+			expectedDiff: true,
+		},
+		"default to numeric version": {
+			old:          tfec2.LaunchTemplateVersionDefault,
+			new:          "2",
+			expectedDiff: true,
+		},
+		"latest": {
+			old:          "2",
+			new:          tfec2.LaunchTemplateVersionLatest,
+			expectedDiff: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			oldConfig := testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", testCase.old)
+			newConfig := testSpotFleetRequestLaunchTemplateConfig("lt-12345678", "", testCase.new)
+
+			sameElement := tfec2.HashLaunchTemplateConfig(oldConfig) == tfec2.HashLaunchTemplateConfig(newConfig)
+			suppressed := tfec2.SuppressLaunchTemplateVersionDefault("", testCase.old, testCase.new, nil) || testCase.old == testCase.new
+
+			if got, want := !(sameElement && suppressed), testCase.expectedDiff; got != want {
+				t.Errorf("diff: got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func testSpotFleetRequestLaunchTemplateConfig(id, name, version string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrVersion: version,
+	}
+	if id != "" {
+		tfMap[names.AttrID] = id
+	}
+	if name != "" {
+		tfMap[names.AttrName] = name
+	}
+
+	return map[string]interface{}{
+		"launch_template_specification": []interface{}{tfMap},
+	}
+}
+
+func TestSpotFleetRequestExpandLaunchTemplateConfigWithoutOverrides(t *testing.T) {
+	t.Parallel()
+
+	launchTemplateSpecification := []interface{}{
+		map[string]interface{}{
+			names.AttrID:      "lt-12345678",
+			names.AttrName:    "",
+			names.AttrVersion: "1",
+		},
+	}
+
+	testCases := map[string]struct {
+		tfMap map[string]interface{}
+	}{
+		"no overrides": {
+			tfMap: map[string]interface{}{
+				"launch_template_specification": launchTemplateSpecification,
+			},
+		},
+		"empty overrides": {
+			tfMap: map[string]interface{}{
+				"launch_template_specification": launchTemplateSpecification,
+				"overrides":                     schema.NewSet(schema.HashString, nil),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			apiObject := tfec2.ExpandLaunchTemplateConfig(testCase.tfMap)
+
+			if apiObject.Overrides != nil {
+				t.Errorf("got overrides %v, want nil", apiObject.Overrides)
+			}
+
+			if got, want := aws.ToString(apiObject.LaunchTemplateSpecification.LaunchTemplateId), "lt-12345678"; got != want {
+				t.Errorf("got launch template ID %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestValidSpotFleetSnapshotVolumeSizes(t *testing.T) {
+	t.Parallel()
+
+	launchSpecification := func(snapshotID string, volumeSize int32) awstypes.SpotFleetLaunchSpecification {
+		return awstypes.SpotFleetLaunchSpecification{
+			BlockDeviceMappings: []awstypes.BlockDeviceMapping{
+				{
+					DeviceName: aws.String("/dev/xvdcz"),
+					Ebs: &awstypes.EbsBlockDevice{
+						SnapshotId: aws.String(snapshotID),
+						VolumeSize: aws.Int32(volumeSize),
+					},
+				},
+			},
+		}
+	}
+	snapshotSizes := map[string]int32{
+		"snap-12345678": 8,
+	}
+
+	testCases := map[string]struct {
+		launchSpecs []awstypes.SpotFleetLaunchSpecification
+		expectError bool
+	}{
+		"no block devices": {
+			launchSpecs: []awstypes.SpotFleetLaunchSpecification{{}},
+		},
+		"volume larger than snapshot": {
+			launchSpecs: []awstypes.SpotFleetLaunchSpecification{launchSpecification("snap-12345678", 10)},
+		},
+		"volume same size as snapshot": {
+			launchSpecs: []awstypes.SpotFleetLaunchSpecification{launchSpecification("snap-12345678", 8)},
+		},
+		"volume smaller than snapshot": {
+			launchSpecs: []awstypes.SpotFleetLaunchSpecification{launchSpecification("snap-12345678", 1)},
+			expectError: true,
+		},
+		"multiple volumes smaller than snapshot": {
+			launchSpecs: []awstypes.SpotFleetLaunchSpecification{launchSpecification("snap-12345678", 1), launchSpecification("snap-12345678", 2)},
+			expectError: true,
+		},
+		"unknown snapshot": {
+			launchSpecs: []awstypes.SpotFleetLaunchSpecification{launchSpecification("snap-87654321", 1)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfec2.ValidSpotFleetSnapshotVolumeSizes(testCase.launchSpecs, snapshotSizes)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("got error %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestUserDataHashSum(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte("#cloud-config\nruncmd:\n  - echo hello\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	gzipped := buf.Bytes()
+
+	testCases := map[string]struct {
+		userData    string
+		apiUserData string
+	}{
+		"plain text": {
+			userData:    "#!/bin/bash\necho hello\n",
+			apiUserData: base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\necho hello\n")),
+		},
+		"base64 text": {
+			userData:    base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\necho hello\n")),
+			apiUserData: base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\necho hello\n")),
+		},
+		"base64 gzip": {
+			userData:    base64.StdEncoding.EncodeToString(gzipped),
+			apiUserData: base64.StdEncoding.EncodeToString(gzipped),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// The configured value and the base64 encoded value returned by the API must hash identically.
+			if got, want := tfec2.UserDataHashSum(testCase.userData), tfec2.UserDataHashSum(testCase.apiUserData); got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+
+	// Compressed user data is hashed over the decoded bytes rather than its base64 encoding.
+	if got, want := tfec2.UserDataHashSum(base64.StdEncoding.EncodeToString(gzipped)), tfec2.UserDataHashSum(string(gzipped)); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestNormalizeDecimalString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"", ""},
+		{"0.05", "0.05"},
+		{"0.0500", "0.05"},
+		{"5e-02", "0.05"},
+		{"0.260000", "0.26"},
+		{"1", "1"},
+		{"1.000", "1"},
+		{"invalid", "invalid"},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := tfec2.NormalizeDecimalString(testCase.value), testCase.expected; got != want {
+			t.Errorf("NormalizeDecimalString(%q) = %q, want %q", testCase.value, got, want)
+		}
+	}
+}
+
+func TestSpotFleetRequestLaunchTemplateOverridesHash(t *testing.T) {
+	t.Parallel()
+
+	overrides := tfec2.ResourceSpotFleetRequest().SchemaMap()["launch_template_config"].Elem.(*schema.Resource).SchemaMap()["overrides"]
+	override := func(spotPrice string) map[string]interface{} {
+		return map[string]interface{}{
+			names.AttrInstanceType: "t3.micro",
+			"spot_price":           spotPrice,
+		}
+	}
+
+	if got, want := overrides.Set(override("0.2600")), overrides.Set(override("0.26")); got != want {
+		t.Errorf("equivalent Spot prices: got hash %d, want %d", got, want)
+	}
+
+	if overrides.Set(override("0.26")) == overrides.Set(override("0.27")) {
+		t.Error("different Spot prices: got equal hashes")
+	}
+
+	// Overrides already in state must keep the hash the default set hash gave them.
+	if got, want := overrides.Set(override("0.26")), schema.HashResource(overrides.Elem.(*schema.Resource))(override("0.26")); got != want {
+		t.Errorf("canonical Spot price: got hash %d, want default hash %d", got, want)
+	}
+}
+
+func TestSpotFleetRequestLaunchSpecToMapWeightedCapacity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		value    float64
+		expected string
+	}{
+		{0.25, "0.25"},
+		{1, "1"},
+		{2.5, "2.5"},
+		{3.5, "3.5"},
+		{6, "6"},
+	}
+
+	for _, testCase := range testCases {
+		m := tfec2.LaunchSpecToMap(context.Background(), awstypes.SpotFleetLaunchSpecification{WeightedCapacity: aws.Float64(testCase.value)}, nil)
+
+		if got, want := m["weighted_capacity"], testCase.expected; got != want {
+			t.Errorf("weighted_capacity for %v = %q, want %q", testCase.value, got, want)
+		}
+	}
+}
+
+func TestSpotFleetRequestLatestHistoryRecord(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	record := func(eventSubType string, timestamp time.Time) awstypes.HistoryRecord {
+		return awstypes.HistoryRecord{
+			EventInformation: &awstypes.EventInformation{
+				EventSubType: aws.String(eventSubType),
+			},
+			EventType: awstypes.EventTypeBatchChange,
+			Timestamp: aws.Time(timestamp),
+		}
+	}
+
+	testCases := map[string]struct {
+		records  []awstypes.HistoryRecord
+		expected string
+	}{
+		"no records": {},
+		"single record": {
+			records:  []awstypes.HistoryRecord{record("submitted", now)},
+			expected: "submitted",
+		},
+		"ascending": {
+			records:  []awstypes.HistoryRecord{record("submitted", now.Add(-time.Minute)), record("active", now)},
+			expected: "active",
+		},
+		"descending": {
+			records:  []awstypes.HistoryRecord{record("active", now), record("submitted", now.Add(-time.Minute))},
+			expected: "active",
+		},
+		"same timestamp": {
+			records:  []awstypes.HistoryRecord{record("submitted", now), record("active", now)},
+			expected: "active",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.LatestSpotFleetRequestHistoryRecord(testCase.records)
+
+			if testCase.expected == "" {
+				if got != nil {
+					t.Fatalf("got %v, want nil", got)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("got nil, want %s", testCase.expected)
+			}
+
+			if got, want := aws.ToString(got.EventInformation.EventSubType), testCase.expected; got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestHistoryError(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	record := func(eventType awstypes.EventType, eventDescription string, timestamp time.Time) awstypes.HistoryRecord {
+		return awstypes.HistoryRecord{
+			EventInformation: &awstypes.EventInformation{
+				EventDescription: aws.String(eventDescription),
+			},
+			EventType: eventType,
+			Timestamp: aws.Time(timestamp),
+		}
+	}
+
+	testCases := map[string]struct {
+		describeSpotFleetRequestHistory func() (*ec2.DescribeSpotFleetRequestHistoryOutput, error)
+		expected                        []string
+	}{
+		"empty history": {
+			describeSpotFleetRequestHistory: func() (*ec2.DescribeSpotFleetRequestHistoryOutput, error) {
+				return &ec2.DescribeSpotFleetRequestHistoryOutput{}, nil
+			},
+		},
+		"history error": {
+			describeSpotFleetRequestHistory: func() (*ec2.DescribeSpotFleetRequestHistoryOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: "UnauthorizedOperation", Message: "You are not authorized to perform this operation."}
+			},
+		},
+		"filtering": {
+			describeSpotFleetRequestHistory: func() (*ec2.DescribeSpotFleetRequestHistoryOutput, error) {
+				return &ec2.DescribeSpotFleetRequestHistoryOutput{
+					HistoryRecords: []awstypes.HistoryRecord{
+						record(awstypes.EventTypeBatchChange, "submitted", now.Add(-4*time.Minute)),
+						record(awstypes.EventTypeError, "error 1", now.Add(-3*time.Minute)),
+						record(awstypes.EventTypeInstanceChange, "launched", now.Add(-2*time.Minute)),
+						record(awstypes.EventTypeInformation, "information 1", now.Add(-time.Minute)),
+						{EventType: awstypes.EventTypeError, Timestamp: aws.Time(now)},
+					},
+				}, nil
+			},
+			expected: []string{"error 1", "information 1"},
+		},
+		"ordering": {
+			describeSpotFleetRequestHistory: func() (*ec2.DescribeSpotFleetRequestHistoryOutput, error) {
+				return &ec2.DescribeSpotFleetRequestHistoryOutput{
+					HistoryRecords: []awstypes.HistoryRecord{
+						record(awstypes.EventTypeError, "error 3", now),
+						record(awstypes.EventTypeError, "error 1", now.Add(-2*time.Minute)),
+						record(awstypes.EventTypeInformation, "information 2", now.Add(-time.Minute)),
+					},
+				}, nil
+			},
+			expected: []string{"error 1", "information 2", "error 3"},
+		},
+		"at most 5 most recent events": {
+			describeSpotFleetRequestHistory: func() (*ec2.DescribeSpotFleetRequestHistoryOutput, error) {
+				var records []awstypes.HistoryRecord
+				for i := 1; i <= 7; i++ {
+					records = append(records, record(awstypes.EventTypeError, fmt.Sprintf("error %d", i), now.Add(time.Duration(i)*time.Minute)))
+				}
+
+				return &ec2.DescribeSpotFleetRequestHistoryOutput{HistoryRecords: records}, nil
+			},
+			expected: []string{"error 3", "error 4", "error 5", "error 6", "error 7"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			conn := ec2.New(ec2.Options{
+				APIOptions: []func(*middleware.Stack) error{
+					addStubResultMiddleware(func(interface{}) (interface{}, error) {
+						return testCase.describeSpotFleetRequestHistory()
+					}),
+				},
+			})
+
+			err := tfec2.SpotFleetRequestHistoryError(ctx, conn, "sfr-12345678")
+
+			if len(testCase.expected) == 0 {
+				if err != nil {
+					t.Fatalf("got error %v, want nil", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("got nil, want %q", testCase.expected)
+			}
+
+			if got, want := err.Error(), strings.Join(testCase.expected, "\n"); got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitSpotFleetRequestFulfilledHistoryError(t *testing.T) {
+	t.Parallel()
+
+	const eventDescription = "There is no Spot capacity available that matches your request."
+
+	ctx := acctest.Context(t)
+
+	conn := ec2.New(ec2.Options{
+		APIOptions: []func(*middleware.Stack) error{
+			addStubResultMiddleware(func(params interface{}) (interface{}, error) {
+				switch params.(type) {
+				case *ec2.DescribeSpotFleetRequestsInput:
+					return &ec2.DescribeSpotFleetRequestsOutput{
+						SpotFleetRequestConfigs: []awstypes.SpotFleetRequestConfig{
+							{
+								ActivityStatus:         awstypes.ActivityStatusError,
+								SpotFleetRequestConfig: &awstypes.SpotFleetRequestConfigData{},
+								SpotFleetRequestId:     aws.String("sfr-12345678"),
+								SpotFleetRequestState:  awstypes.BatchStateActive,
+							},
+						},
+					}, nil
+				case *ec2.DescribeSpotFleetRequestHistoryInput:
+					return &ec2.DescribeSpotFleetRequestHistoryOutput{
+						HistoryRecords: []awstypes.HistoryRecord{
+							{
+								EventInformation: &awstypes.EventInformation{
+									EventDescription: aws.String(eventDescription),
+									EventSubType:     aws.String("launchSpecUnusable"),
+								},
+								EventType: awstypes.EventTypeError,
+								Timestamp: aws.Time(time.Now()),
+							},
+						},
+					}, nil
+				}
+
+				return nil, errors.New("unexpected operation")
+			}),
+		},
+	})
+
+	_, err := tfec2.WaitSpotFleetRequestFulfilled(ctx, conn, "sfr-12345678", time.Minute)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got, want := err.Error(), "launchSpecUnusable: "+eventDescription; !strings.Contains(got, want) {
+		t.Errorf("got error %q, want it to contain %q", got, want)
+	}
+}
+
+func TestWaitSpotFleetRequestScaledInWeightedCapacity(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	// With a weighted capacity of 2, a target capacity of 3 is fulfilled by 4 units.
+	activityStatuses := []awstypes.ActivityStatus{awstypes.ActivityStatusPendingTermination, awstypes.ActivityStatusFulfilled}
+	conn := ec2.New(ec2.Options{
+		APIOptions: []func(*middleware.Stack) error{
+			addStubResultMiddleware(func(params interface{}) (interface{}, error) {
+				if _, ok := params.(*ec2.DescribeSpotFleetRequestsInput); !ok {
+					return nil, errors.New("unexpected operation")
+				}
+
+				activityStatus := activityStatuses[0]
+				if len(activityStatuses) > 1 {
+					activityStatuses = activityStatuses[1:]
+				}
+
+				return &ec2.DescribeSpotFleetRequestsOutput{
+					SpotFleetRequestConfigs: []awstypes.SpotFleetRequestConfig{
+						{
+							ActivityStatus: activityStatus,
+							SpotFleetRequestConfig: &awstypes.SpotFleetRequestConfigData{
+								FulfilledCapacity: aws.Float64(4),
+								LaunchSpecifications: []awstypes.SpotFleetLaunchSpecification{
+									{WeightedCapacity: aws.Float64(2)},
+								},
+								TargetCapacity: aws.Int32(3),
+							},
+							SpotFleetRequestId:    aws.String("sfr-12345678"),
+							SpotFleetRequestState: awstypes.BatchStateActive,
+						},
+					},
+				}, nil
+			}),
+		},
+	})
+
+	output, err := tfec2.WaitSpotFleetRequestScaledIn(ctx, conn, "sfr-12345678", 2*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToFloat64(output.SpotFleetRequestConfig.FulfilledCapacity), 4.0; got != want {
+		t.Errorf("got fulfilled capacity %v, want %v", got, want)
+	}
+}
+
+func TestSpotFleetRequestRootBlockDeviceToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bdm         []awstypes.BlockDeviceMapping
+		rootDevName *string
+		expected    int
+	}{
+		"no root device name": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), Ebs: &awstypes.EbsBlockDevice{VolumeSize: aws.Int32(8)}},
+			},
+		},
+		"EBS root device": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), Ebs: &awstypes.EbsBlockDevice{VolumeSize: aws.Int32(8)}},
+				{DeviceName: aws.String("/dev/sdb"), VirtualName: aws.String("ephemeral0")},
+			},
+			rootDevName: aws.String("/dev/xvda"),
+			expected:    1,
+		},
+		"instance store root device": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), VirtualName: aws.String("ephemeral0")},
+			},
+			rootDevName: aws.String("/dev/xvda"),
+		},
+		"suppressed root device": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), NoDevice: aws.String("")},
+			},
+			rootDevName: aws.String("/dev/xvda"),
+		},
+		"empty EBS root device": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), Ebs: &awstypes.EbsBlockDevice{}},
+			},
+			rootDevName: aws.String("/dev/xvda"),
+			expected:    1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfec2.RootBlockDeviceToSet(testCase.bdm, testCase.rootDevName).Len(), testCase.expected; got != want {
+				t.Errorf("got %d root block devices, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestEBSBlockDevicesToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bdm         []awstypes.BlockDeviceMapping
+		rootDevName *string
+		expected    int
+	}{
+		"empty mapping": {
+			bdm: []awstypes.BlockDeviceMapping{{}},
+		},
+		"no device name": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{Ebs: &awstypes.EbsBlockDevice{}},
+			},
+			expected: 1,
+		},
+		"empty EBS device": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/sdb"), Ebs: &awstypes.EbsBlockDevice{}},
+			},
+			expected: 1,
+		},
+		"root device": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), Ebs: &awstypes.EbsBlockDevice{}},
+				{DeviceName: aws.String("/dev/sdb"), Ebs: &awstypes.EbsBlockDevice{}},
+			},
+			rootDevName: aws.String("/dev/xvda"),
+			expected:    1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfec2.EBSBlockDevicesToSet(testCase.bdm, testCase.rootDevName).Len(), testCase.expected; got != want {
+				t.Errorf("got %d EBS block devices, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestSpotFleetRequestEphemeralBlockDevicesToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bdm      []awstypes.BlockDeviceMapping
+		expected int
+	}{
+		"empty mapping": {
+			bdm: []awstypes.BlockDeviceMapping{{}},
+		},
+		"EBS device": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/sdb"), Ebs: &awstypes.EbsBlockDevice{}},
+			},
+		},
+		"no device name": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{VirtualName: aws.String("ephemeral0")},
+			},
+			expected: 1,
+		},
+		"suppressed device without device name": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{NoDevice: aws.String("")},
+			},
+			expected: 1,
+		},
+		"instance store and suppressed devices": {
+			bdm: []awstypes.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/sdb"), VirtualName: aws.String("ephemeral0")},
+				{DeviceName: aws.String("/dev/sdc"), NoDevice: aws.String("")},
+			},
+			expected: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfec2.EphemeralBlockDevicesToSet(testCase.bdm).Len(), testCase.expected; got != want {
+				t.Errorf("got %d ephemeral block devices, want %d", got, want)
+			}
+		})
+	}
+}
+
+func testAccCheckSpotFleetRequestRecreatedConfig(t *testing.T,
+	before, after *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.SpotFleetRequestId == after.SpotFleetRequestId {
+			t.Fatalf("Expected change of Spot Fleet Request IDs, but both were %v", before.SpotFleetRequestId)
+		}
+		return nil
+	}
+}
+
+func testAccCheckSpotFleetRequestNotRecreated(t *testing.T,
+	before, after *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(before.SpotFleetRequestId) != aws.ToString(after.SpotFleetRequestId) {
+			t.Fatalf("Expected Spot Fleet Request ID to be unchanged, but was %v and is now %v", aws.ToString(before.SpotFleetRequestId), aws.ToString(after.SpotFleetRequestId))
+		}
+		return nil
+	}
+}
+
+func testAccCheckSpotFleetRequestCancelled(ctx context.Context, v *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		id := aws.ToString(v.SpotFleetRequestId)
+		_, err := tfec2.FindSpotFleetRequestByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Spot Fleet Request %s still exists", id)
+	}
+}
+
+func testAccCheckSpotFleetRequestUpdateTags(ctx context.Context, v *awstypes.SpotFleetRequestConfig, oldTags, newTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		return tfec2.UpdateTagsV2(ctx, conn, aws.ToString(v.SpotFleetRequestId), oldTags, newTags)
+	}
+}
+
+func testAccCheckSpotFleetRequestExists(ctx context.Context, n string, v *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No EC2 Spot Fleet Request ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindSpotFleetRequestByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSpotFleetRequestClientToken(n string, v *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if v.SpotFleetRequestConfig.ClientToken == nil {
+			return nil
+		}
+
+		if got, want := rs.Primary.Attributes["client_token"], aws.ToString(v.SpotFleetRequestConfig.ClientToken); got != want {
+			return fmt.Errorf("client_token = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSpotFleetRequestDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_spot_fleet_request" {
+				continue
+			}
+
+			_, err := tfec2.FindSpotFleetRequestByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Spot Fleet Request %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSpotFleetRequest_EBSAttributes(sfr *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(sfr.SpotFleetRequestConfig.LaunchSpecifications) == 0 {
+			return errors.New("Missing launch specification")
+		}
+
+		spec := sfr.SpotFleetRequestConfig.LaunchSpecifications[0]
+
+		ebs := spec.BlockDeviceMappings
+		if len(ebs) < 2 {
+			return fmt.Errorf("Expected %d block device mappings, got %d", 2, len(ebs))
+		}
+
+		if *ebs[0].DeviceName != "/dev/xvda" {
+			return fmt.Errorf("Expected device 0's name to be %s, got %s", "/dev/xvda", *ebs[0].DeviceName)
+		}
+		if *ebs[1].DeviceName != "/dev/xvdcz" {
+			return fmt.Errorf("Expected device 1's name to be %s, got %s", "/dev/xvdcz", *ebs[1].DeviceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSpotFleetRequest_PlacementAttributes(
+	sfr *awstypes.SpotFleetRequestConfig, rName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(sfr.SpotFleetRequestConfig.LaunchSpecifications) == 0 {
+			return errors.New("Missing launch specification")
+		}
+
+		spec := sfr.SpotFleetRequestConfig.LaunchSpecifications[0]
+
+		placement := spec.Placement
+		if placement == nil {
+			return fmt.Errorf("Expected placement to be set, got nil")
+		}
+		if placement.Tenancy != awstypes.TenancyDedicated {
+			return fmt.Errorf("Expected placement tenancy to be %q, got %q", "dedicated", placement.Tenancy)
+		}
+
+		if aws.ToString(placement.GroupName) != rName {
+			return fmt.Errorf("Expected placement group to be %q, got %q", rName, aws.ToString(placement.GroupName))
+		}
+
+		return nil
+	}
+}
+
+func testAccPreCheckSpotFleetRequest(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+	_, err := tfec2.FindSpotFleetRequests(ctx, conn, &ec2.DescribeSpotFleetRequestsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckSpotFleetRequest_AssociatePublicIPAddress(sfr *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(sfr.SpotFleetRequestConfig.LaunchSpecifications) == 0 {
+			return errors.New("Missing launch specification")
+		}
+
+		spec := sfr.SpotFleetRequestConfig.LaunchSpecifications[0]
+
+		if spec.SubnetId != nil && aws.ToString(spec.SubnetId) != "" {
+			return fmt.Errorf("Expected SubnetId to be moved to the network interface, got %s", aws.ToString(spec.SubnetId))
+		}
+
+		if len(spec.SecurityGroups) != 0 {
+			return fmt.Errorf("Expected SecurityGroups to be moved to the network interface, got %d", len(spec.SecurityGroups))
+		}
+
+		if len(spec.NetworkInterfaces) != 1 {
+			return fmt.Errorf("Expected 1 network interface, got %d", len(spec.NetworkInterfaces))
+		}
+
+		if ni := spec.NetworkInterfaces[0]; !aws.ToBool(ni.AssociatePublicIpAddress) || aws.ToString(ni.SubnetId) == "" || len(ni.Groups) != 1 {
+			return fmt.Errorf("Expected network interface with a public IP address, subnet and security group, got %+v", ni)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSpotFleetRequest_IAMInstanceProfileARN(sfr *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
 		if len(sfr.SpotFleetRequestConfig.LaunchSpecifications) == 0 {
 			return errors.New("Missing launch specification")
 		}
 
-		spec := sfr.SpotFleetRequestConfig.LaunchSpecifications[0]
+		spec := sfr.SpotFleetRequestConfig.LaunchSpecifications[0]
+
+		profile := spec.IamInstanceProfile
+		if profile == nil {
+			return fmt.Errorf("Expected IamInstanceProfile to be set, got nil")
+		}
+		//Validate the string whether it is ARN
+		re := regexache.MustCompile(fmt.Sprintf(`arn:%s:iam::\d{12}:instance-profile/?[0-9A-Za-z@-_+=,.].*`, acctest.Partition())) // regex seems suspicious, @-_ is a range
+		if !re.MatchString(*profile.Arn) {
+			return fmt.Errorf("Expected IamInstanceProfile input as ARN, got %s", *profile.Arn)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSpotFleetRequestInstanceRequirementsNetworkBandwidthGbps(sfr *awstypes.SpotFleetRequestConfig, min, max float64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, v := range sfr.SpotFleetRequestConfig.LaunchTemplateConfigs {
+			for _, v := range v.Overrides {
+				if v.InstanceRequirements == nil || v.InstanceRequirements.NetworkBandwidthGbps == nil {
+					continue
+				}
+
+				if got := v.InstanceRequirements.NetworkBandwidthGbps; aws.ToFloat64(got.Min) != min || aws.ToFloat64(got.Max) != max {
+					return fmt.Errorf("Expected network bandwidth between %v and %v Gbps, got between %v and %v Gbps", min, max, aws.ToFloat64(got.Min), aws.ToFloat64(got.Max))
+				}
+
+				return nil
+			}
+		}
+
+		return errors.New("Missing instance requirements network bandwidth")
+	}
+}
+
+func testAccCheckSpotFleetRequestInstanceRequirementsAcceleratorCountMax(sfr *awstypes.SpotFleetRequestConfig, max int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, v := range sfr.SpotFleetRequestConfig.LaunchTemplateConfigs {
+			for _, v := range v.Overrides {
+				if v.InstanceRequirements == nil || v.InstanceRequirements.AcceleratorCount == nil {
+					continue
+				}
+
+				if got := v.InstanceRequirements.AcceleratorCount.Max; got == nil {
+					return errors.New("Missing instance requirements maximum accelerator count")
+				} else if aws.ToInt32(got) != max {
+					return fmt.Errorf("Expected maximum accelerator count of %d, got %d", max, aws.ToInt32(got))
+				}
+
+				return nil
+			}
+		}
+
+		return errors.New("Missing instance requirements accelerator count")
+	}
+}
+
+func testAccSpotFleetRequestConfig_base(rName, publicKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_key_pair" "test" {
+  key_name   = %[1]q
+  public_key = "%[2]s"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "spotfleet.${data.aws_partition.current.dns_suffix}",
+          "ec2.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_policy" "test" {
+  name        = %[1]q
+  path        = "/"
+  description = "Spot Fleet Request ACCTest Policy"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "ec2:DescribeImages",
+        "ec2:DescribeSubnets",
+        "ec2:RequestSpotInstances",
+        "ec2:TerminateInstances",
+        "ec2:DescribeInstanceStatus",
+        "iam:PassRole"
+      ],
+      "Resource": [
+        "*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_policy_attachment" "test" {
+  name       = %[1]q
+  roles      = [aws_iam_role.test.name]
+  policy_arn = aws_iam_policy.test.arn
+}
+`, rName, publicKey))
+}
+
+func testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_zeroTargetCapacityWaitForFulfillment(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 0
+  valid_until                         = %[2]q
+  fleet_type                          = "maintain"
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_targetCapacityWaitForScaleIn(rName, publicKey, validUntil string, targetCapacity int) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = %[3]d
+  valid_until                         = %[2]q
+  fleet_type                          = "maintain"
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+  wait_for_scale_in                   = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, targetCapacity))
+}
+
+func testAccSpotFleetRequestConfig_userDataGzip(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+    user_data     = base64gzip("#cloud-config\nruncmd:\n  - echo %[1]s\n")
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_count(rName, publicKey, validUntil string, count int) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  count = %[3]d
+
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, count))
+}
+
+func testAccSpotFleetRequestConfig_tagsCount(rName, publicKey, validUntil string, count int) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  tags = { for i in range(%[3]d) : "key${i}" => "value${i}" }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, count))
+}
+
+func testAccSpotFleetRequestConfig_noValidUntil(rName, publicKey string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                = aws_iam_role.test.arn
+  spot_price                    = "0.05"
+  target_capacity               = 1
+  terminate_instances_on_delete = true
+  wait_for_fulfillment          = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccSpotFleetRequestConfig_instanceInterruptionBehavior(rName, publicKey, validUntil, instanceInterruptionBehavior string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = %[3]q
+  wait_for_fulfillment                = false
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, instanceInterruptionBehavior))
+}
+
+func testAccSpotFleetRequestConfig_replaceUnhealthyInstances(rName, publicKey, validUntil string, replaceUnhealthyInstances bool) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  replace_unhealthy_instances         = %[3]t
+  wait_for_fulfillment                = false
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, replaceUnhealthyInstances))
+}
+
+func testAccSpotFleetRequestConfig_context(rName, publicKey, validUntil, contextId string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  context                             = %[3]q
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, contextId))
+}
+
+func testAccSpotFleetRequestConfig_tags1(rName, publicKey, validUntil, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, tagKey1, tagValue1))
+}
+
+func testAccSpotFleetRequestConfig_tags2(rName, publicKey, validUntil, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccSpotFleetRequestConfig_associatePublicIPAddress(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type               = data.aws_ec2_instance_type_offering.available.instance_type
+    ami                         = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name                    = aws_key_pair.test.key_name
+    associate_public_ip_address = true
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_associatePublicIPAddressSubnet(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type               = data.aws_ec2_instance_type_offering.available.instance_type
+    ami                         = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name                    = aws_key_pair.test.key_name
+    subnet_id                   = aws_subnet.test.id
+    vpc_security_group_ids      = [aws_security_group.test.id]
+    associate_public_ip_address = true
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test, aws_internet_gateway.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_targetCapacity(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 3
+  valid_until                         = %[2]q
+  fleet_type                          = "request"
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_launchTemplate(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  key_name      = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_launchTemplateSpecification(rName, publicKey, validUntil, launchTemplateSpecification string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  key_name      = aws_key_pair.test.key_name
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_template_config {
+    launch_template_specification {
+      %[3]s
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, launchTemplateSpecification))
+}
+
+func testAccSpotFleetRequestConfig_launchTemplateInstancePoolsToUseCount(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  key_name      = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  allocation_strategy                 = "lowestPrice"
+  instance_pools_to_use_count         = 2
+  wait_for_fulfillment                = true
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+
+    overrides {
+      availability_zone = data.aws_availability_zones.available.names[0]
+    }
+
+    overrides {
+      availability_zone = data.aws_availability_zones.available.names[1]
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_launchTemplateMultiple(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+data "aws_ec2_instance_type_offering" "test" {
+  filter {
+    name   = "instance-type"
+    values = ["t1.micro"]
+  }
+
+  preferred_instance_types = ["t1.micro"]
+}
+
+resource "aws_launch_template" "test1" {
+  name          = "%[1]s-1"
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  key_name      = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "aws_launch_template" "test2" {
+  name          = "%[1]s-2"
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.test.instance_type
+  key_name      = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test1.name
+      version = aws_launch_template.test1.latest_version
+    }
+  }
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test2.name
+      version = aws_launch_template.test2.latest_version
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_launchTemplateInstanceTypeOverrides(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  key_name      = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+
+    overrides {
+      instance_type     = "t1.micro"
+      weighted_capacity = "2"
+    }
 
-		placement := spec.Placement
-		if placement == nil {
-			return fmt.Errorf("Expected placement to be set, got nil")
-		}
-		if placement.Tenancy != awstypes.TenancyDedicated {
-			return fmt.Errorf("Expected placement tenancy to be %q, got %q", "dedicated", placement.Tenancy)
-		}
+    overrides {
+      instance_type = "m3.medium"
+      priority      = 1
+      spot_price    = "0.26"
+    }
+  }
 
-		if aws.ToString(placement.GroupName) != rName {
-			return fmt.Errorf("Expected placement group to be %q, got %q", rName, aws.ToString(placement.GroupName))
-		}
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
 
-		return nil
-	}
+func testAccSpotFleetRequestConfig_launchTemplateOverridesSpotPrice(rName, publicKey, validUntil, spotPrice string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
 }
 
-func testAccPreCheckSpotFleetRequest(ctx context.Context, t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
 
-	_, err := tfec2.FindSpotFleetRequests(ctx, conn, &ec2.DescribeSpotFleetRequestsInput{})
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
 
-	if acctest.PreCheckSkipError(err) {
-		t.Skipf("skipping acceptance testing: %s", err)
-	}
+    overrides {
+      instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+      spot_price    = %[3]q
+    }
+  }
 
-	if err != nil {
-		t.Fatalf("unexpected PreCheck error: %s", err)
-	}
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, spotPrice))
 }
 
-func testAccCheckSpotFleetRequest_IAMInstanceProfileARN(sfr *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		if len(sfr.SpotFleetRequestConfig.LaunchSpecifications) == 0 {
-			return errors.New("Missing launch specification")
-		}
+func testAccSpotFleetRequestConfig_launchSpecPublicIPWithLaunchTemplate(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
+}
 
-		spec := sfr.SpotFleetRequestConfig.LaunchSpecifications[0]
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = false
 
-		profile := spec.IamInstanceProfile
-		if profile == nil {
-			return fmt.Errorf("Expected IamInstanceProfile to be set, got nil")
-		}
-		//Validate the string whether it is ARN
-		re := regexache.MustCompile(fmt.Sprintf(`arn:%s:iam::\d{12}:instance-profile/?[0-9A-Za-z@-_+=,.].*`, acctest.Partition())) // regex seems suspicious, @-_ is a range
-		if !re.MatchString(*profile.Arn) {
-			return fmt.Errorf("Expected IamInstanceProfile input as ARN, got %s", *profile.Arn)
-		}
+  launch_specification {
+    instance_type               = data.aws_ec2_instance_type_offering.available.instance_type
+    ami                         = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    associate_public_ip_address = true
+  }
 
-		return nil
-	}
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+
+    overrides {
+      instance_requirements {
+        vcpu_count {
+          min = 1
+          max = 8
+        }
+
+        memory_mib {
+          min = 500
+          max = 50000
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_base(rName, publicKey string) string {
-	return acctest.ConfigCompose(
-		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
-		acctest.ConfigAvailableAZsNoOptIn(),
-		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
-		fmt.Sprintf(`
-resource "aws_key_pair" "test" {
-  key_name   = %[1]q
-  public_key = "%[2]s"
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverridesPublicIP(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
 
   tags = {
     Name = %[1]q
   }
 }
 
-data "aws_partition" "current" {}
+resource "aws_subnet" "test" {
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
 
-resource "aws_iam_role" "test" {
-  name = %[1]q
+  tags = {
+    Name = %[1]q
+  }
+}
 
-  assume_role_policy = <<EOF
-{
-  "Version": "2012-10-17",
-  "Statement": [
-    {
-      "Sid": "",
-      "Effect": "Allow",
-      "Principal": {
-        "Service": [
-          "spotfleet.${data.aws_partition.current.dns_suffix}",
-          "ec2.${data.aws_partition.current.dns_suffix}"
-        ]
-      },
-      "Action": "sts:AssumeRole"
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
+
+  network_interfaces {
+    device_index                = 0
+    associate_public_ip_address = true
+    subnet_id                   = aws_subnet.test.id
+  }
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
     }
-  ]
+  }
 }
-EOF
 
-  tags = {
-    Name = %[1]q
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = false
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+
+    overrides {
+      instance_requirements {
+        vcpu_count {
+          min = 1
+          max = 8
+        }
+
+        memory_mib {
+          min = 500
+          max = 50000
+        }
+
+        instance_generations = ["current"]
+      }
+    }
   }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
 }
 
-resource "aws_iam_policy" "test" {
-  name        = %[1]q
-  path        = "/"
-  description = "Spot Fleet Request ACCTest Policy"
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsMemoryGiBPerVCPU(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
 
-  policy = <<EOF
-{
-  "Version": "2012-10-17",
-  "Statement": [
-    {
-      "Effect": "Allow",
-      "Action": [
-        "ec2:DescribeImages",
-        "ec2:DescribeSubnets",
-        "ec2:RequestSpotInstances",
-        "ec2:TerminateInstances",
-        "ec2:DescribeInstanceStatus",
-        "iam:PassRole"
-      ],
-      "Resource": [
-        "*"
-      ]
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
     }
-  ]
-}
-EOF
+  }
 }
 
-resource "aws_iam_policy_attachment" "test" {
-  name       = %[1]q
-  roles      = [aws_iam_role.test.name]
-  policy_arn = aws_iam_policy.test.arn
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = false
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+
+    overrides {
+      instance_requirements {
+        vcpu_count {
+          min = 1
+          max = 8
+        }
+
+        memory_mib {
+          min = 500
+        }
+
+        memory_gib_per_vcpu {
+          min = 4.0
+          max = 8.0
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
 }
-`, rName, publicKey))
+`, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_basic(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsNetworkBandwidthGbps(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
-  spot_price                          = "0.07"
-  target_capacity                     = 2
+  target_capacity                     = 1
   valid_until                         = %[2]q
   terminate_instances_with_expiration = true
-  instance_interruption_behaviour     = "stop"
-  wait_for_fulfillment                = true
+  wait_for_fulfillment                = false
 
-  launch_specification {
-    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-    key_name      = aws_key_pair.test.key_name
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
 
-    tags = {
-      Name = %[1]q
+    overrides {
+      instance_requirements {
+        vcpu_count {
+          min = 1
+          max = 8
+        }
+
+        memory_mib {
+          min = 500
+        }
+
+        network_bandwidth_gbps {
+          min = 1.5
+          max = 40
+        }
+      }
     }
   }
 
@@ -2028,112 +6688,141 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_context(rName, publicKey, validUntil, contextId string) string {
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverridesSpotPrice(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
+}
+
 resource "aws_spot_fleet_request" "test" {
-  context                             = %[3]q
   iam_fleet_role                      = aws_iam_role.test.arn
-  spot_price                          = "0.07"
-  target_capacity                     = 2
+  target_capacity                     = 1
   valid_until                         = %[2]q
   terminate_instances_with_expiration = true
-  instance_interruption_behaviour     = "stop"
-  wait_for_fulfillment                = true
+  wait_for_fulfillment                = false
 
-  launch_specification {
-    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-    key_name      = aws_key_pair.test.key_name
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
 
-    tags = {
-      Name = %[1]q
+    overrides {
+      spot_price = "0.05"
+
+      instance_requirements {
+        vcpu_count {
+          min = 1
+          max = 8
+        }
+
+        memory_mib {
+          min = 500
+        }
+      }
     }
   }
 
   depends_on = [aws_iam_policy_attachment.test]
 }
-`, rName, validUntil, contextId))
+`, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_tags1(rName, publicKey, validUntil, tagKey1, tagValue1 string) string {
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsLocalStorage(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
-resource "aws_spot_fleet_request" "test" {
-  iam_fleet_role                      = aws_iam_role.test.arn
-  spot_price                          = "0.07"
-  target_capacity                     = 2
-  valid_until                         = %[2]q
-  terminate_instances_with_expiration = true
-  instance_interruption_behaviour     = "stop"
-  wait_for_fulfillment                = true
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
 
-  launch_specification {
-    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-    key_name      = aws_key_pair.test.key_name
+  tag_specifications {
+    resource_type = "instance"
 
     tags = {
       Name = %[1]q
     }
   }
-
-  tags = {
-    %[3]q = %[4]q
-  }
-
-  depends_on = [aws_iam_policy_attachment.test]
-}
-`, rName, validUntil, tagKey1, tagValue1))
 }
 
-func testAccSpotFleetRequestConfig_tags2(rName, publicKey, validUntil, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
-	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
-  spot_price                          = "0.07"
-  target_capacity                     = 2
+  target_capacity                     = 1
   valid_until                         = %[2]q
   terminate_instances_with_expiration = true
-  instance_interruption_behaviour     = "stop"
-  wait_for_fulfillment                = true
-
-  launch_specification {
-    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-    key_name      = aws_key_pair.test.key_name
+  wait_for_fulfillment                = false
 
-    tags = {
-      Name = %[1]q
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
     }
-  }
 
-  tags = {
-    %[3]q = %[4]q
-    %[5]q = %[6]q
+    overrides {
+      instance_requirements {
+        vcpu_count {
+          min = 1
+          max = 8
+        }
+
+        memory_mib {
+          min = 500
+        }
+
+        local_storage       = "required"
+        local_storage_types = ["hdd", "ssd"]
+      }
+    }
   }
 
   depends_on = [aws_iam_policy_attachment.test]
 }
-`, rName, validUntil, tagKey1, tagValue1, tagKey2, tagValue2))
+`, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_associatePublicIPAddress(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_launchTemplateOverridesMixedPriority(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
-  spot_price                          = "0.07"
-  target_capacity                     = 2
+  allocation_strategy                 = "capacityOptimizedPrioritized"
+  target_capacity                     = 1
   valid_until                         = %[2]q
   terminate_instances_with_expiration = true
-  wait_for_fulfillment                = true
+  wait_for_fulfillment                = false
 
-  launch_specification {
-    instance_type               = data.aws_ec2_instance_type_offering.available.instance_type
-    ami                         = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-    key_name                    = aws_key_pair.test.key_name
-    associate_public_ip_address = true
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
 
-    tags = {
-      Name = %[1]q
+    overrides {
+      instance_type = "t3.micro"
+      priority      = 1
+    }
+
+    overrides {
+      instance_type = "t3.small"
+      priority      = 2
+    }
+
+    overrides {
+      instance_type = "t3.medium"
     }
   }
 
@@ -2142,23 +6831,44 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_targetCapacity(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_launchTemplateOverridesPriorityFloat(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
-  spot_price                          = "0.07"
-  target_capacity                     = 3
+  allocation_strategy                 = "capacityOptimizedPrioritized"
+  target_capacity                     = 1
   valid_until                         = %[2]q
-  fleet_type                          = "request"
   terminate_instances_with_expiration = true
-  wait_for_fulfillment                = true
+  wait_for_fulfillment                = false
 
-  launch_specification {
-    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
 
-    tags = {
-      Name = %[1]q
+    overrides {
+      instance_type = "t3.micro"
+      priority      = 10.0
+    }
+
+    overrides {
+      instance_type = "t3.small"
+      priority      = 10.5
     }
   }
 
@@ -2167,7 +6877,7 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_launchTemplate(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverrides(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_launch_template" "test" {
   name          = %[1]q
@@ -2198,51 +6908,41 @@ resource "aws_spot_fleet_request" "test" {
       name    = aws_launch_template.test.name
       version = aws_launch_template.test.latest_version
     }
-  }
-
-  depends_on = [aws_iam_policy_attachment.test]
-}
-`, rName, validUntil))
-}
-
-func testAccSpotFleetRequestConfig_launchTemplateMultiple(rName, publicKey, validUntil string) string {
-	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
-data "aws_ec2_instance_type_offering" "test" {
-  filter {
-    name   = "instance-type"
-    values = ["t1.micro"]
-  }
 
-  preferred_instance_types = ["t1.micro"]
-}
+    overrides {
+      availability_zone = data.aws_availability_zones.available.names[2]
 
-resource "aws_launch_template" "test1" {
-  name          = "%[1]s-1"
-  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-  key_name      = aws_key_pair.test.key_name
+      instance_requirements {
+        vcpu_count {
+          min = 1
+          max = 8
+        }
 
-  tag_specifications {
-    resource_type = "instance"
+        memory_mib {
+          min = 500
+          max = 50000
+        }
 
-    tags = {
-      Name = %[1]q
+        instance_generations = ["current"]
+      }
     }
   }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
 }
 
-resource "aws_launch_template" "test2" {
-  name          = "%[1]s-2"
+func testAccSpotFleetRequestConfig_launchSpecMonitoringWithLaunchTemplate(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
   image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-  instance_type = data.aws_ec2_instance_type_offering.test.instance_type
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
   key_name      = aws_key_pair.test.key_name
 
-  tag_specifications {
-    resource_type = "instance"
-
-    tags = {
-      Name = %[1]q
-    }
+  monitoring {
+    enabled = false
   }
 }
 
@@ -2252,20 +6952,17 @@ resource "aws_spot_fleet_request" "test" {
   target_capacity                     = 2
   valid_until                         = %[2]q
   terminate_instances_with_expiration = true
-  instance_interruption_behaviour     = "stop"
-  wait_for_fulfillment                = true
 
-  launch_template_config {
-    launch_template_specification {
-      name    = aws_launch_template.test1.name
-      version = aws_launch_template.test1.latest_version
-    }
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    monitoring    = true
   }
 
   launch_template_config {
     launch_template_specification {
-      name    = aws_launch_template.test2.name
-      version = aws_launch_template.test2.latest_version
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
     }
   }
 
@@ -2274,13 +6971,12 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_launchTemplateInstanceTypeOverrides(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirements(rName, publicKey, validUntil, instanceRequirements string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_launch_template" "test" {
-  name          = %[1]q
-  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-  key_name      = aws_key_pair.test.key_name
+  name     = %[1]q
+  image_id = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  key_name = aws_key_pair.test.key_name
 
   tag_specifications {
     resource_type = "instance"
@@ -2293,12 +6989,11 @@ resource "aws_launch_template" "test" {
 
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
-  spot_price                          = "0.07"
-  target_capacity                     = 2
+  target_capacity                     = 1
   valid_until                         = %[2]q
   terminate_instances_with_expiration = true
   instance_interruption_behaviour     = "stop"
-  wait_for_fulfillment                = true
+  wait_for_fulfillment                = false
 
   launch_template_config {
     launch_template_specification {
@@ -2307,85 +7002,78 @@ resource "aws_spot_fleet_request" "test" {
     }
 
     overrides {
-      instance_type     = "t1.micro"
-      weighted_capacity = "2"
-    }
+      availability_zone = data.aws_availability_zones.available.names[0]
 
-    overrides {
-      instance_type = "m3.medium"
-      priority      = 1
-      spot_price    = "0.26"
+      instance_requirements {
+        %[3]s
+      }
     }
   }
 
   depends_on = [aws_iam_policy_attachment.test]
 }
-`, rName, validUntil))
+`, rName, validUntil, instanceRequirements))
 }
 
-func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsOverrides(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_excessCapacityTermination(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
-resource "aws_launch_template" "test" {
-  name          = %[1]q
-  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
-  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
-  key_name      = aws_key_pair.test.key_name
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  excess_capacity_termination_policy  = "NoTermination"
+  valid_until                         = %[2]q
+  fleet_type                          = "request"
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
 
-  tag_specifications {
-    resource_type = "instance"
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
 
     tags = {
       Name = %[1]q
     }
   }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_excessCapacityTerminationPolicy(rName, publicKey, validUntil, policy string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
   spot_price                          = "0.07"
   target_capacity                     = 2
+  excess_capacity_termination_policy  = %[3]q
   valid_until                         = %[2]q
   terminate_instances_with_expiration = true
   instance_interruption_behaviour     = "stop"
   wait_for_fulfillment                = true
 
-  launch_template_config {
-    launch_template_specification {
-      name    = aws_launch_template.test.name
-      version = aws_launch_template.test.latest_version
-    }
-
-    overrides {
-      availability_zone = data.aws_availability_zones.available.names[2]
-
-      instance_requirements {
-        vcpu_count {
-          min = 1
-          max = 8
-        }
-
-        memory_mib {
-          min = 500
-          max = 50000
-        }
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
 
-        instance_generations = ["current"]
-      }
+    tags = {
+      Name = %[1]q
     }
   }
 
   depends_on = [aws_iam_policy_attachment.test]
 }
-`, rName, validUntil))
+`, rName, validUntil, policy))
 }
 
-func testAccSpotFleetRequestConfig_excessCapacityTermination(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_type(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
   spot_price                          = "0.07"
   target_capacity                     = 2
-  excess_capacity_termination_policy  = "NoTermination"
   valid_until                         = %[2]q
   fleet_type                          = "request"
   terminate_instances_with_expiration = true
@@ -2405,15 +7093,16 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
-func testAccSpotFleetRequestConfig_type(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_fleetTypeCreateBeforeDestroy(rName, publicKey, validUntil, fleetType string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
-  spot_price                          = "0.07"
-  target_capacity                     = 2
+  spot_price                          = "0.05"
+  target_capacity                     = 1
   valid_until                         = %[2]q
-  fleet_type                          = "request"
+  fleet_type                          = %[3]q
   terminate_instances_with_expiration = true
+  terminate_instances_on_delete       = true
   wait_for_fulfillment                = true
 
   launch_specification {
@@ -2425,9 +7114,13 @@ resource "aws_spot_fleet_request" "test" {
     }
   }
 
+  lifecycle {
+    create_before_destroy = true
+  }
+
   depends_on = [aws_iam_policy_attachment.test]
 }
-`, rName, validUntil))
+`, rName, validUntil, fleetType))
 }
 
 func testAccSpotFleetRequestConfig_iamInstanceProfileARN(rName, publicKey, validUntil string) string {
@@ -2501,6 +7194,53 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_launchSpecificationAMI(rName, publicKey, validUntil, ami string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.05"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = %[3]q
+    key_name      = aws_key_pair.test.key_name
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, ami))
+}
+
+func testAccSpotFleetRequestConfig_iamInstanceProfileNameAndARN(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_iam_instance_profile" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.05"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+
+  launch_specification {
+    instance_type            = data.aws_ec2_instance_type_offering.available.instance_type
+    ami                      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name                 = aws_key_pair.test.key_name
+    iam_instance_profile     = aws_iam_instance_profile.test.name
+    iam_instance_profile_arn = aws_iam_instance_profile.test.arn
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
 func testAccSpotFleetRequestConfig_changeBidPrice(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
@@ -3035,6 +7775,33 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_allocationStrategies(rName, publicKey, validUntil, allocationStrategy, onDemandAllocationStrategy string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  allocation_strategy                 = %[3]q
+  on_demand_allocation_strategy       = %[4]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = false
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, allocationStrategy, onDemandAllocationStrategy))
+}
+
 func testAccSpotFleetRequestConfig_weightedCapacity(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
@@ -3071,10 +7838,101 @@ resource "aws_spot_fleet_request" "test" {
 
   depends_on = [aws_iam_policy_attachment.test]
 }
-`, rName, validUntil))
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_weightedCapacityFractional(rName, publicKey, validUntil, weightedCapacity string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 3
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type     = data.aws_ec2_instance_type_offering.available.instance_type
+    ami               = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name          = aws_key_pair.test.key_name
+    weighted_capacity = %[3]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, weightedCapacity))
+}
+
+func testAccSpotFleetRequestConfig_ebs(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+
+    ebs_block_device {
+      device_name = "/dev/xvda"
+      volume_type = "gp2"
+      volume_size = "8"
+    }
+
+    ebs_block_device {
+      device_name = "/dev/xvdcz"
+      volume_type = "gp2"
+      volume_size = "100"
+    }
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
+func testAccSpotFleetRequestConfig_launchSpecificationEphemeralBlockDeviceArguments(rName, publicKey, validUntil, arguments string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = false
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+
+    ephemeral_block_device {
+      device_name = "/dev/sdb"
+      %[3]s
+    }
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, arguments))
 }
 
-func testAccSpotFleetRequestConfig_ebs(rName, publicKey, validUntil string) string {
+func testAccSpotFleetRequestConfig_launchSpecificationEphemeralBlockDeviceNoDevice(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
   iam_fleet_role                      = aws_iam_role.test.arn
@@ -3088,16 +7946,14 @@ resource "aws_spot_fleet_request" "test" {
     instance_type = data.aws_ec2_instance_type_offering.available.instance_type
     ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
 
-    ebs_block_device {
-      device_name = "/dev/xvda"
-      volume_type = "gp2"
-      volume_size = "8"
+    ephemeral_block_device {
+      device_name = "/dev/sdb"
+      no_device   = true
     }
 
-    ebs_block_device {
-      device_name = "/dev/xvdcz"
-      volume_type = "gp2"
-      volume_size = "100"
+    ephemeral_block_device {
+      device_name  = "/dev/sdc"
+      virtual_name = "ephemeral0"
     }
 
     tags = {
@@ -3156,6 +8012,84 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_launchSpecificationEBSBlockDeviceSnapshot(rName, publicKey, validUntil string, volumeSize int) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 2
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  terminate_instances_with_expiration = true
+  valid_until                         = %[2]q
+  validate_ebs_snapshot_volume_size   = true
+  wait_for_fulfillment                = false
+
+  launch_specification {
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    instance_type = "t2.micro"
+
+    ebs_block_device {
+      device_name = "/dev/xvdcz"
+      snapshot_id = aws_ebs_snapshot.test.id
+      volume_type = "gp2"
+      volume_size = %[3]d
+    }
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, volumeSize))
+}
+
+func testAccSpotFleetRequestConfig_validateEBSSnapshotVolumeSizeWithLaunchTemplate(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  key_name      = aws_key_pair.test.key_name
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  validate_ebs_snapshot_volume_size   = true
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
 func testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceKMSKeyID(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -3195,6 +8129,36 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceDefaultKMSKey(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  terminate_instances_with_expiration = true
+  valid_until                         = %[2]q
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    instance_type = "t2.micro"
+
+    root_block_device {
+      encrypted   = true
+      volume_type = "gp2"
+      volume_size = 10
+    }
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
 func testAccSpotFleetRequestConfig_launchSpecificationEBSBlockDeviceGP3(rName, publicKey string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
@@ -3262,6 +8226,69 @@ resource "aws_spot_fleet_request" "test" {
 `, rName))
 }
 
+func testAccSpotFleetRequestConfig_launchSpecificationBlockDevicePerformance(rName, publicKey, blockDevice, volumeType string, iops, throughput int) string {
+	deviceName := ""
+	if blockDevice == "ebs_block_device" {
+		deviceName = `device_name = "/dev/xvdcz"`
+	}
+
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  terminate_instances_with_expiration = true
+
+  launch_specification {
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    instance_type = "t2.micro"
+
+    %[2]s {
+      %[3]s
+      iops        = %[5]d
+      throughput  = %[6]d
+      volume_size = 15
+      volume_type = %[4]q
+    }
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, blockDevice, deviceName, volumeType, iops, throughput))
+}
+
+func testAccSpotFleetRequestConfig_launchSpecificationRootBlockDeviceGP3Defaults(rName, publicKey string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    instance_type = "t2.micro"
+
+    root_block_device {
+      volume_size = 15
+      volume_type = "gp3"
+    }
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName))
+}
+
 func testAccSpotFleetRequestConfig_launchSpecificationInstanceStoreAMI(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(
 		testAccAMIDataSourceConfig_latestUbuntuBionicHVMInstanceStore(),
@@ -3346,6 +8373,31 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_placementTenancy(rName, publicKey, validUntil, tenancy string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+
+  launch_specification {
+    instance_type     = data.aws_ec2_instance_type_offering.available.instance_type
+    ami               = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name          = aws_key_pair.test.key_name
+    placement_tenancy = %[3]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, tenancy))
+}
+
 func testAccSpotFleetRequestConfig_zeroCapacity(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
@@ -3372,6 +8424,33 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_maintainTargetCapacity(rName, publicKey, validUntil string, targetCapacity int) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = %[3]d
+  valid_until                         = %[2]q
+  fleet_type                          = "maintain"
+  excess_capacity_termination_policy  = "Default"
+  terminate_instances_with_expiration = true
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, targetCapacity))
+}
+
 func testAccSpotFleetRequestConfig_capacityRebalance(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
@@ -3403,6 +8482,37 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_capacityRebalanceInvalidType(rName, publicKey, validUntil string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  fleet_type                          = "request"
+  terminate_instances_with_expiration = true
+
+  spot_maintenance_strategies {
+    capacity_rebalance {
+      replacement_strategy = "launch"
+    }
+  }
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil))
+}
+
 func testAccSpotFleetRequestConfig_onDemandTargetCapacity(rName, publicKey, validUntil string, targetCapacity int) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -3546,6 +8656,33 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_terminateInstancesOnDelete(rName, publicKey, validUntil, terminateInstancesOnDelete string) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 2
+  valid_until                         = %[2]q
+  terminate_instances_on_delete       = %[3]q
+  terminate_instances_with_expiration = true
+  instance_interruption_behaviour     = "stop"
+  wait_for_fulfillment                = true
+
+  launch_specification {
+    instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+    ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+    key_name      = aws_key_pair.test.key_name
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, terminateInstancesOnDelete))
+}
+
 func testAccSpotFleetRequestConfig_targetCapacityUnitType(rName, publicKey, validUntil, targetCapacityUnitType string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -89,8 +89,13 @@ var (
 	ResourceVolumeAttachment                         = resourceVolumeAttachment
 
 	CustomFiltersSchema                                        = customFiltersSchema
+	EBSBlockDevicesToSet                                       = ebsBlockDevicesToSet
+	EphemeralBlockDevicesToSet                                 = ephemeralBlockDevicesToSet
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
+	ExpandInstanceRequirements                                 = expandInstanceRequirements
+	ExpandLaunchTemplateConfig                                 = expandLaunchTemplateConfig
+	FilterActiveInstancesByHealth                              = filterActiveInstancesByHealth
 	FindAvailabilityZones                                      = findAvailabilityZones
 	FindCapacityReservationByID                                = findCapacityReservationByID
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
@@ -180,17 +185,35 @@ var (
 	FindVerifiedAccessInstanceTrustProviderAttachmentExists    = findVerifiedAccessInstanceTrustProviderAttachmentExists
 	FindVerifiedAccessTrustProviderByID                        = findVerifiedAccessTrustProviderByID
 	FindVolumeAttachmentInstanceByID                           = findVolumeAttachmentInstanceByID
+	FlattenInstanceRequirements                                = flattenInstanceRequirements
 	FlattenNetworkInterfacePrivateIPAddresses                  = flattenNetworkInterfacePrivateIPAddresses
+	HashLaunchTemplateConfig                                   = hashLaunchTemplateConfig
 	IPAMServicePrincipal                                       = ipamServicePrincipal
+	IsSpotFleetRequestIAMPropagationError                      = isSpotFleetRequestIAMPropagationError
+	LatestSpotFleetRequestHistoryRecord                        = latestSpotFleetRequestHistoryRecord
+	LaunchSpecToMap                                            = launchSpecToMap
+	LaunchTemplateConfigsWithVersionDefault                    = launchTemplateConfigsWithVersionDefault
 	NewAttributeFilterList                                     = newAttributeFilterList
 	NewAttributeFilterListV2                                   = newAttributeFilterListV2
 	NewCustomFilterList                                        = newCustomFilterList
 	NewTagFilterList                                           = newTagFilterList
+	NormalizeDecimalString                                     = normalizeDecimalString
 	ProtocolForValue                                           = protocolForValue
+	RequestSpotFleet                                           = requestSpotFleet
+	RootBlockDeviceToSet                                       = rootBlockDeviceToSet
+	SpotFleetRequestHistoryError                               = spotFleetRequestHistoryError
+	SpotFleetRequestTargetsHealthy                             = spotFleetRequestTargetsHealthy
 	StopEBSVolumeAttachmentInstance                            = stopVolumeAttachmentInstance
 	StopInstance                                               = stopInstance
+	SuppressLaunchTemplateVersionDefault                       = suppressLaunchTemplateVersionDefault
 	UpdateTags                                                 = updateTags
 	UpdateTagsV2                                               = updateTagsV2
+	UserDataHashSum                                            = userDataHashSum
+	ValidSpotFleetInstanceRequirementsRanges                   = validSpotFleetInstanceRequirementsRanges
+	ValidSpotFleetSnapshotVolumeSizes                          = validSpotFleetSnapshotVolumeSizes
+	WaitSpotFleetRequestFulfilled                              = waitSpotFleetRequestFulfilled
+	WaitSpotFleetRequestScaledIn                               = waitSpotFleetRequestScaledIn
+	WaitSpotFleetRequestTargetsHealthy                         = waitSpotFleetRequestTargetsHealthy
 	WaitVolumeAttachmentCreated                                = waitVolumeAttachmentCreated
 )
 
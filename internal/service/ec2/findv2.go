@@ -1590,6 +1590,27 @@ func findSpotFleetRequestHistoryRecords(ctx context.Context, conn *ec2.Client, i
 	return output, nil
 }
 
+func findSpotFleetRequestLatestHistoryRecordByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.HistoryRecord, error) {
+	input := &ec2.DescribeSpotFleetRequestHistoryInput{
+		SpotFleetRequestId: aws.String(id),
+		StartTime:          aws.Time(spotFleetRequestLatestHistoryStartTime()),
+	}
+
+	output, err := findSpotFleetRequestHistoryRecords(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	record := latestSpotFleetRequestHistoryRecord(output)
+
+	if record == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return record, nil
+}
+
 func findVPCEndpointServiceConfigurationByServiceName(ctx context.Context, conn *ec2.Client, name string) (*awstypes.ServiceConfiguration, error) {
 	input := &ec2.DescribeVpcEndpointServiceConfigurationsInput{
 		Filters: newAttributeFilterListV2(map[string]string{
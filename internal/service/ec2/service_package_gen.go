@@ -422,6 +422,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSecurityGroups,
 			TypeName: "aws_security_groups",
 		},
+		{
+			Factory:  dataSourceSpotFleetRequestInstances,
+			TypeName: "aws_spot_fleet_request_instances",
+			Name:     "Spot Fleet Request Instances",
+		},
 		{
 			Factory:  DataSourceSubnet,
 			TypeName: "aws_subnet",
@@ -28,6 +28,24 @@ func validSecurityGroupRuleDescription(v interface{}, k string) (ws []string, er
 	return
 }
 
+// validInstanceTypePattern validates an instance type that may contain wildcards,
+// e.g. "m5.large", "m5.*", "c5*.*", "r*" or "*".
+func validInstanceTypePattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 30 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 30 characters: %q", k, value))
+	}
+
+	pattern := `^[0-9a-z*-]+(\.[0-9a-z*-]+)?$`
+	if !regexache.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be an instance type or a wildcard pattern such as \"m5.*\" (%q): %q",
+			k, pattern, value))
+	}
+	return
+}
+
 // validNestedExactlyOneOf is called on the map representing a nested schema element
 // Once ExactlyOneOf is supported for nested elements, this should be deprecated.
 func validNestedExactlyOneOf(m map[string]interface{}, valid []string) error {
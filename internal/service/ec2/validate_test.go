@@ -37,3 +37,42 @@ func TestValidSecurityGroupRuleDescription(t *testing.T) {
 		}
 	}
 }
+
+func TestValidInstanceTypePattern(t *testing.T) {
+	t.Parallel()
+
+	validPatterns := []string{
+		"m5.large",
+		"m5.*",
+		"c5*.*",
+		"r*",
+		"*3*",
+		"*",
+		"u-6tb1.metal",
+		"mac2-m2pro.metal",
+	}
+	for _, v := range validPatterns {
+		_, errors := validInstanceTypePattern(v, "allowed_instance_types")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid instance type pattern: %q", v, errors)
+		}
+	}
+
+	invalidPatterns := []string{
+		"",
+		"m5.",
+		".large",
+		"m5..large",
+		"m5.large.x",
+		"M5.Large",
+		"m5 large",
+		"m5,large",
+		"abcdefghijklmnop.abcdefghijklmnop",
+	}
+	for _, v := range invalidPatterns {
+		_, errors := validInstanceTypePattern(v, "allowed_instance_types")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid instance type pattern", v)
+		}
+	}
+}
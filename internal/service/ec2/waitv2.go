@@ -7,15 +7,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -785,6 +788,10 @@ func waitSpotFleetRequestCreated(ctx context.Context, conn *ec2.Client, id strin
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
+	if err != nil {
+		tfresource.SetLastError(err, spotFleetRequestHistoryError(ctx, conn, id))
+	}
+
 	if output, ok := outputRaw.(*awstypes.SpotFleetRequestConfig); ok {
 		return output, err
 	}
@@ -806,30 +813,74 @@ func waitSpotFleetRequestFulfilled(ctx context.Context, conn *ec2.Client, id str
 
 	if output, ok := outputRaw.(*awstypes.SpotFleetRequestConfig); ok {
 		if output.ActivityStatus == awstypes.ActivityStatusError {
-			var errs []error
-
-			input := &ec2.DescribeSpotFleetRequestHistoryInput{
-				SpotFleetRequestId: aws.String(id),
-				StartTime:          aws.Time(time.UnixMilli(0)),
-			}
-
-			if output, err := findSpotFleetRequestHistoryRecords(ctx, conn, input); err == nil {
-				for _, v := range output {
-					if eventType := v.EventType; eventType == awstypes.EventTypeError || eventType == awstypes.EventTypeInformation {
-						errs = append(errs, errors.New(aws.ToString(v.EventInformation.EventDescription)))
-					}
-				}
-			}
-
-			tfresource.SetLastError(err, errors.Join(errs...))
+			tfresource.SetLastError(err, spotFleetRequestHistoryError(ctx, conn, id))
 		}
 
 		return output, err
 	}
 
+	// Fulfillment never completes if there is insufficient Spot capacity.
+	// Report recent history events rather than a bare timeout.
+	if tfresource.TimedOut(err) {
+		tfresource.SetLastError(err, spotFleetRequestHistoryError(ctx, conn, id))
+	}
+
 	return nil, err
 }
 
+// spotFleetRequestHistoryError returns the most recent error and information events recorded
+// in the specified Spot Fleet request's history as a single error, or nil if there are none.
+// It is intended to be called only once a request has failed.
+func spotFleetRequestHistoryError(ctx context.Context, conn *ec2.Client, id string) error {
+	const (
+		maxEvents = 5
+	)
+
+	input := &ec2.DescribeSpotFleetRequestHistoryInput{
+		SpotFleetRequestId: aws.String(id),
+		StartTime:          aws.Time(spotFleetRequestHistoryStartTime()),
+	}
+
+	output, err := findSpotFleetRequestHistoryRecords(ctx, conn, input)
+
+	if err != nil {
+		return nil
+	}
+
+	output = tfslices.Filter(output, func(v awstypes.HistoryRecord) bool {
+		eventType := v.EventType
+		return v.EventInformation != nil && (eventType == awstypes.EventTypeError || eventType == awstypes.EventTypeInformation)
+	})
+	slices.SortStableFunc(output, func(a, b awstypes.HistoryRecord) int {
+		return aws.ToTime(a.Timestamp).Compare(aws.ToTime(b.Timestamp))
+	})
+	if n := len(output); n > maxEvents {
+		output = output[n-maxEvents:]
+	}
+
+	var errs []error
+	for _, v := range output {
+		errs = append(errs, newSpotFleetRequestHistoryRecordError(v))
+	}
+
+	return errors.Join(errs...)
+}
+
+func newSpotFleetRequestHistoryRecordError(apiObject awstypes.HistoryRecord) error {
+	eventInformation := apiObject.EventInformation
+	message := aws.ToString(eventInformation.EventDescription)
+
+	if v := aws.ToString(eventInformation.EventSubType); v != "" {
+		message = fmt.Sprintf("%s: %s", v, message)
+	}
+
+	if v := aws.ToString(eventInformation.InstanceId); v != "" {
+		message = fmt.Sprintf("instanceId %s %s", v, message)
+	}
+
+	return errors.New(message)
+}
+
 func waitSpotFleetRequestUpdated(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SpotFleetRequestConfig, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.BatchStateModifying),
@@ -849,6 +900,66 @@ func waitSpotFleetRequestUpdated(ctx context.Context, conn *ec2.Client, id strin
 	return nil, err
 }
 
+// waitSpotFleetRequestScaledIn waits for a Spot Fleet to terminate its excess capacity.
+// Fulfilled capacity can remain above the target with weighted capacity, so the activity status is waited on.
+func waitSpotFleetRequestScaledIn(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.SpotFleetRequestConfig, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ActivityStatusPendingFulfillment, awstypes.ActivityStatusPendingTermination),
+		Target:     enum.Slice(awstypes.ActivityStatusFulfilled),
+		Refresh:    statusSpotFleetActivityStatus(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.SpotFleetRequestConfig); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSpotFleetRequestTargetsHealthy(ctx context.Context, conn *ec2.Client, elbv2Conn *elasticloadbalancingv2.Client, id string, targetGroupARNs []string, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		instances, err := findSpotFleetInstances(ctx, conn, &ec2.DescribeSpotFleetInstancesInput{
+			SpotFleetRequestId: aws.String(id),
+		})
+
+		if err != nil {
+			return false, err
+		}
+
+		if len(instances) == 0 {
+			return false, nil
+		}
+
+		instanceIDs := tfslices.ApplyToAll(instances, func(v awstypes.ActiveInstance) string {
+			return aws.ToString(v.InstanceId)
+		})
+
+		for _, targetGroupARN := range targetGroupARNs {
+			output, err := elbv2Conn.DescribeTargetHealth(ctx, &elasticloadbalancingv2.DescribeTargetHealthInput{
+				TargetGroupArn: aws.String(targetGroupARN),
+			})
+
+			if err != nil {
+				return false, fmt.Errorf("reading ELBv2 Target Group (%s) target health: %w", targetGroupARN, err)
+			}
+
+			if !spotFleetRequestTargetsHealthy(instanceIDs, output.TargetHealthDescriptions) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	})
+}
+
 func waitVPCEndpointServiceAvailable(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.ServiceConfiguration, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(awstypes.ServiceStatePending),
@@ -5,6 +5,12 @@ package scheduler
 
 // Exports for use in tests only.
 var (
-	FindScheduleByTwoPartKey = findScheduleByTwoPartKey
-	ResourceSchedule         = resourceSchedule
+	CreateScheduleGroup                   = createScheduleGroup
+	CronScheduleExpressionTimezoneWarning = cronScheduleExpressionTimezoneWarning
+	FindScheduleByTwoPartKey              = findScheduleByTwoPartKey
+	NewStubClient                         = newStubClient
+	NormalizeScheduleExpression           = normalizeScheduleExpression
+	NormalizeTargetInput                  = normalizeTargetInput
+	ResourceSchedule                      = resourceSchedule
+	ValidateTargetSQSParameters           = validateTargetSQSParameters
 )
@@ -37,3 +37,64 @@ func findScheduleGroupByName(ctx context.Context, conn *scheduler.Client, name s
 
 	return out, nil
 }
+
+func findSchedulesByGroupName(ctx context.Context, conn *scheduler.Client, groupName string) ([]types.ScheduleSummary, error) {
+	in := &scheduler.ListSchedulesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	return findSchedules(ctx, conn, in)
+}
+
+// findScheduleNamesByGroupName returns the names of the schedules in the specified group.
+// Schedules deleted a moment ago may still be listed, so each listed schedule is confirmed to exist.
+func findScheduleNamesByGroupName(ctx context.Context, conn *scheduler.Client, groupName string) ([]string, error) {
+	schedules, err := findSchedulesByGroupName(ctx, conn, groupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var out []string
+	for _, v := range schedules {
+		name := aws.ToString(v.Name)
+		_, err := findScheduleByTwoPartKey(ctx, conn, groupName, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, name)
+	}
+
+	return out, nil
+}
+
+func findSchedules(ctx context.Context, conn *scheduler.Client, in *scheduler.ListSchedulesInput) ([]types.ScheduleSummary, error) {
+	var out []types.ScheduleSummary
+
+	pages := scheduler.NewListSchedulesPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			var nfe *types.ResourceNotFoundException
+			if errors.As(err, &nfe) {
+				return nil, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: in,
+				}
+			}
+
+			return nil, err
+		}
+
+		out = append(out, page.Schedules...)
+	}
+
+	return out, nil
+}
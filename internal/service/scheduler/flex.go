@@ -628,7 +628,9 @@ func expandTarget(ctx context.Context, tfMap map[string]interface{}) *types.Targ
 	}
 
 	if v, ok := tfMap["input"].(string); ok && v != "" {
-		a.Input = aws.String(v)
+		a.Input = aws.String(normalizeTargetInput(aws.ToString(a.Arn), v))
+	} else if isUniversalTargetARN(aws.ToString(a.Arn)) {
+		a.Input = aws.String(universalTargetDefaultInput)
 	}
 
 	if v, ok := tfMap["kinesis_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
@@ -677,8 +679,9 @@ func flattenTarget(ctx context.Context, apiObject *types.Target) map[string]inte
 		m["eventbridge_parameters"] = []interface{}{flattenEventBridgeParameters(v)}
 	}
 
-	if v := apiObject.Input; v != nil {
-		m["input"] = aws.ToString(v)
+	// An omitted input may be returned as either null or an empty string.
+	if v := aws.ToString(apiObject.Input); v != "" {
+		m["input"] = v
 	}
 
 	if v := apiObject.KinesisParameters; v != nil {
@@ -5,6 +5,7 @@ package scheduler
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
@@ -13,7 +14,9 @@ import (
 )
 
 const (
-	iamPropagationTimeout = 2 * time.Minute
+	iamPropagationTimeout           = 2 * time.Minute
+	propagationTimeout              = 1 * time.Minute
+	scheduleGroupPropagationTimeout = 20 * time.Second
 )
 
 func retryWhenIAMNotPropagated[T any](ctx context.Context, f func() (T, error)) (T, error) {
@@ -39,3 +42,30 @@ func retryWhenIAMNotPropagated[T any](ctx context.Context, f func() (T, error))
 
 	return v.(T), nil
 }
+
+// retryWhenScheduleGroupNotFound retries when the named schedule group, if created in the same apply,
+// is not yet visible to the schedule operation. The window is kept short as a group that
+// genuinely does not exist fails the same way.
+func retryWhenScheduleGroupNotFound[T any](ctx context.Context, groupName string, f func() (T, error)) (T, error) {
+	v, err := tfresource.RetryWhen(
+		ctx,
+		scheduleGroupPropagationTimeout,
+		func() (interface{}, error) {
+			return f()
+		},
+		func(err error) (bool, error) {
+			if errs.IsAErrorMessageContains[*types.ResourceNotFoundException](err, fmt.Sprintf("Schedule group %s does not exist", groupName)) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return v.(T), nil
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestRetryWhenScheduleGroupNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		errs          []error
+		expectedCalls int
+		expectError   bool
+	}{
+		"success": {
+			expectedCalls: 1,
+		},
+		"transient group not found": {
+			errs: []error{
+				&types.ResourceNotFoundException{Message: aws.String("Schedule group test does not exist.")},
+			},
+			expectedCalls: 2,
+		},
+		"other resource not found": {
+			errs: []error{
+				&types.ResourceNotFoundException{Message: aws.String("Role arn:aws:iam::123456789012:role/test does not exist.")},
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		"other schedule group not found": {
+			errs: []error{
+				&types.ResourceNotFoundException{Message: aws.String("Schedule group other does not exist.")},
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		"other error": {
+			errs: []error{
+				&types.ValidationException{Message: aws.String("Invalid request.")},
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			got, err := retryWhenScheduleGroupNotFound(ctx, "test", func() (string, error) {
+				calls++
+				if calls <= len(testCase.errs) {
+					return "", testCase.errs[calls-1]
+				}
+
+				return "ok", nil
+			})
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("got %d calls, want %d", calls, testCase.expectedCalls)
+			}
+
+			if testCase.expectError {
+				if !errors.Is(err, testCase.errs[0]) {
+					t.Errorf("got error %v, want %v", err, testCase.errs[0])
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != "ok" {
+				t.Errorf("got %q, want %q", got, "ok")
+			}
+		})
+	}
+}
+
+func TestFindScheduleByTwoPartKeyWhenNew(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		isNewResource bool
+		expectedCalls int
+		expectError   bool
+	}{
+		"new resource": {
+			isNewResource: true,
+			expectedCalls: 2,
+		},
+		"existing resource": {
+			expectedCalls: 1,
+			expectError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			// The schedule only becomes visible on the second GetSchedule call.
+			var calls int
+			conn := newStubClient(func(interface{}) (interface{}, error) {
+				calls++
+				if calls == 1 {
+					return nil, &types.ResourceNotFoundException{Message: aws.String("Schedule test does not exist.")}
+				}
+
+				return &scheduler.GetScheduleOutput{Arn: aws.String("arn")}, nil
+			})
+
+			got, err := findScheduleByTwoPartKeyWhenNew(ctx, conn, "default", "test", testCase.isNewResource)
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("got %d calls, want %d", calls, testCase.expectedCalls)
+			}
+
+			if testCase.expectError {
+				if !tfresource.NotFound(err) {
+					t.Errorf("expected not found error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := aws.ToString(got.Arn), "arn"; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
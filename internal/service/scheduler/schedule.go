@@ -6,6 +6,7 @@ package scheduler
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -44,6 +46,8 @@ func resourceSchedule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceScheduleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -61,8 +65,18 @@ func resourceSchedule() *schema.Resource {
 			},
 			"flexible_time_window": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				MaxItems: 1,
+				// An omitted window is OFF, the default, so removing a window that is OFF is not a change.
+				// Removing any other window reverts it to OFF.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					o, n := d.GetChange("flexible_time_window")
+					if v := o.([]interface{}); len(n.([]interface{})) == 0 && len(v) > 0 && v[0] != nil {
+						return v[0].(map[string]interface{})[names.AttrMode].(string) == string(types.FlexibleTimeWindowModeOff)
+					}
+
+					return false
+				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"maximum_window_in_minutes": {
@@ -92,6 +106,10 @@ func resourceSchedule() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
 			},
+			"last_modification_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrName: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -118,11 +136,14 @@ func resourceSchedule() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeScheduleExpression(old) == normalizeScheduleExpression(new)
+				},
 			},
 			"schedule_expression_timezone": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				Default:          "UTC",
+				Default:          scheduleExpressionTimezoneDefault,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 50)),
 			},
 			"start_date": {
@@ -145,7 +166,7 @@ func resourceSchedule() *schema.Resource {
 						names.AttrARN: {
 							Type:             schema.TypeString,
 							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+							ValidateDiagFunc: validation.ToDiagFunc(validTargetARN),
 						},
 						"dead_letter_config": {
 							Type:     schema.TypeList,
@@ -156,7 +177,7 @@ func resourceSchedule() *schema.Resource {
 									names.AttrARN: {
 										Type:             schema.TypeString,
 										Required:         true,
-										ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+										ValidateDiagFunc: validation.ToDiagFunc(validDeadLetterConfigARN),
 									},
 								},
 							},
@@ -324,9 +345,21 @@ func resourceSchedule() *schema.Resource {
 							},
 						},
 						"input": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, math.MaxInt)),
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+								validation.StringLenBetween(1, math.MaxInt),
+								validTargetInputContextAttributes,
+							)),
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								// An omitted input is sent to universal targets as an empty JSON object.
+								if old == universalTargetDefaultInput && new == "" && isUniversalTargetARN(d.Get("target.0.arn").(string)) {
+									return true
+								}
+
+								// Input to templated targets need not be JSON. Input that is not JSON is compared as is.
+								return verify.SuppressEquivalentJSONDiffs(k, old, new, d)
+							},
 						},
 						"kinesis_parameters": {
 							Type:     schema.TypeList,
@@ -375,7 +408,7 @@ func resourceSchedule() *schema.Resource {
 						names.AttrRoleARN: {
 							Type:             schema.TypeString,
 							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
+							ValidateDiagFunc: validation.ToDiagFunc(validRoleARN),
 						},
 						"sagemaker_pipeline_parameters": {
 							Type:     schema.TypeList,
@@ -429,6 +462,8 @@ func resourceSchedule() *schema.Resource {
 
 const (
 	ResNameSchedule = "Schedule"
+
+	scheduleExpressionTimezoneDefault = "UTC"
 )
 
 func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -438,6 +473,9 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 	name := create.Name(d.Get(names.AttrName).(string), d.Get(names.AttrNamePrefix).(string))
 
 	in := &scheduler.CreateScheduleInput{
+		FlexibleTimeWindow: &types.FlexibleTimeWindow{
+			Mode: types.FlexibleTimeWindowModeOff,
+		},
 		Name:               aws.String(name),
 		ScheduleExpression: aws.String(d.Get(names.AttrScheduleExpression).(string)),
 	}
@@ -451,7 +489,7 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 		in.EndDate = aws.Time(v)
 	}
 
-	if v, ok := d.Get("flexible_time_window").([]interface{}); ok && len(v) > 0 {
+	if v, ok := d.Get("flexible_time_window").([]interface{}); ok && len(v) > 0 && v[0] != nil {
 		in.FlexibleTimeWindow = expandFlexibleTimeWindow(v[0].(map[string]interface{}))
 	}
 
@@ -480,9 +518,37 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 		in.Target = expandTarget(ctx, v[0].(map[string]interface{}))
 	}
 
-	out, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.CreateScheduleOutput, error) {
-		return conn.CreateSchedule(ctx, in)
-	})
+	// The target may not have been known when the plan was checked.
+	if err := validateTargetSQSParameters(d.Get("target.0.arn").(string), d.Get("target.0.sqs_parameters").([]interface{})); err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionCreating, ResNameSchedule, name, err)
+	}
+
+	if err := validateTargetInput(d.Get("target.0.arn").(string), d.Get("target.0.input").(string)); err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionCreating, ResNameSchedule, name, err)
+	}
+
+	f := func() (*scheduler.CreateScheduleOutput, error) {
+		return retryWhenIAMNotPropagated(ctx, func() (*scheduler.CreateScheduleOutput, error) {
+			return conn.CreateSchedule(ctx, in)
+		})
+	}
+
+	var out *scheduler.CreateScheduleOutput
+	var err error
+	if in.GroupName != nil {
+		// A schedule group created in the same configuration may not be visible yet.
+		out, err = retryWhenScheduleGroupNotFound(ctx, aws.ToString(in.GroupName), f)
+	} else {
+		out, err = f()
+	}
+
+	// Only check for a missing schedule group once creation has failed so as not to
+	// add an API call, or race with a schedule group created in the same configuration.
+	if groupName := aws.ToString(in.GroupName); errs.IsA[*types.ResourceNotFoundException](err) && groupName != "" {
+		if _, err := findScheduleGroupByName(ctx, conn, groupName); tfresource.NotFound(err) {
+			return create.AppendDiagError(diags, names.Scheduler, create.ErrActionCreating, ResNameSchedule, name, fmt.Errorf("schedule group (%s) does not exist, create it with the aws_scheduler_schedule_group resource", groupName))
+		}
+	}
 
 	if err != nil {
 		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionCreating, ResNameSchedule, name, err)
@@ -507,6 +573,8 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(id)
 
+	diags = append(diags, cronScheduleExpressionTimezoneWarning(d.Get(names.AttrScheduleExpression).(string), d.GetRawConfig().GetAttr("schedule_expression_timezone"))...)
+
 	return append(diags, resourceScheduleRead(ctx, d, meta)...)
 }
 
@@ -520,7 +588,7 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionReading, ResNameSchedule, d.Id(), fmt.Errorf("invalid resource id: %w", err))
 	}
 
-	out, err := findScheduleByTwoPartKey(ctx, conn, groupName, scheduleName)
+	out, err := findScheduleByTwoPartKeyWhenNew(ctx, conn, groupName, scheduleName, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EventBridge Scheduler Schedule (%s) not found, removing from state", d.Id())
@@ -547,10 +615,22 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set(names.AttrGroupName, out.GroupName)
 	d.Set(names.AttrKMSKeyARN, out.KmsKeyArn)
+
+	if out.LastModificationDate != nil {
+		d.Set("last_modification_date", aws.ToTime(out.LastModificationDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_modification_date", nil)
+	}
+
 	d.Set(names.AttrName, out.Name)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(out.Name)))
 	d.Set(names.AttrScheduleExpression, out.ScheduleExpression)
-	d.Set("schedule_expression_timezone", out.ScheduleExpressionTimezone)
+	// The API omits the timezone when it is the default.
+	if v := aws.ToString(out.ScheduleExpressionTimezone); v != "" {
+		d.Set("schedule_expression_timezone", v)
+	} else {
+		d.Set("schedule_expression_timezone", scheduleExpressionTimezoneDefault)
+	}
 
 	if out.StartDate != nil {
 		d.Set("start_date", aws.ToTime(out.StartDate).Format(time.RFC3339))
@@ -572,13 +652,28 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
 
 	in := &scheduler.UpdateScheduleInput{
-		FlexibleTimeWindow: expandFlexibleTimeWindow(d.Get("flexible_time_window").([]interface{})[0].(map[string]interface{})),
+		FlexibleTimeWindow: &types.FlexibleTimeWindow{
+			Mode: types.FlexibleTimeWindowModeOff,
+		},
 		GroupName:          aws.String(d.Get(names.AttrGroupName).(string)),
 		Name:               aws.String(d.Get(names.AttrName).(string)),
 		ScheduleExpression: aws.String(d.Get(names.AttrScheduleExpression).(string)),
 		Target:             expandTarget(ctx, d.Get(names.AttrTarget).([]interface{})[0].(map[string]interface{})),
 	}
 
+	// The target may not have been known when the plan was checked.
+	if err := validateTargetSQSParameters(d.Get("target.0.arn").(string), d.Get("target.0.sqs_parameters").([]interface{})); err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionUpdating, ResNameSchedule, d.Id(), err)
+	}
+
+	if err := validateTargetInput(d.Get("target.0.arn").(string), d.Get("target.0.input").(string)); err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionUpdating, ResNameSchedule, d.Id(), err)
+	}
+
+	if v, ok := d.Get("flexible_time_window").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in.FlexibleTimeWindow = expandFlexibleTimeWindow(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.Get(names.AttrDescription).(string); ok && v != "" {
 		in.Description = aws.String(v)
 	}
@@ -588,6 +683,7 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		in.EndDate = aws.Time(v)
 	}
 
+	// UpdateSchedule replaces the schedule, so omitting the key reverts to an AWS owned key.
 	if v, ok := d.Get(names.AttrKMSKeyARN).(string); ok && v != "" {
 		in.KmsKeyArn = aws.String(v)
 	}
@@ -615,6 +711,10 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionUpdating, ResNameSchedule, d.Id(), err)
 	}
 
+	if d.HasChanges(names.AttrScheduleExpression, "schedule_expression_timezone") {
+		diags = append(diags, cronScheduleExpressionTimezoneWarning(d.Get(names.AttrScheduleExpression).(string), d.GetRawConfig().GetAttr("schedule_expression_timezone"))...)
+	}
+
 	return append(diags, resourceScheduleRead(ctx, d, meta)...)
 }
 
@@ -647,6 +747,76 @@ func resourceScheduleDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+func resourceScheduleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	arnKnown := diff.NewValueKnown("target.0.arn")
+	arn := diff.Get("target.0.arn").(string)
+
+	// A target that is not yet known is checked on create or update.
+	if !arnKnown {
+		return nil
+	}
+
+	if diff.NewValueKnown("target.0.input") {
+		if err := validateTargetInput(arn, diff.Get("target.0.input").(string)); err != nil {
+			return err
+		}
+	}
+
+	if err := validateTargetSQSParameters(arn, diff.Get("target.0.sqs_parameters").([]interface{})); err != nil {
+		return err
+	}
+
+	// Message group IDs only apply to FIFO queues.
+	if diff.NewValueKnown("target.0.sqs_parameters.0.message_group_id") {
+		if v := diff.Get("target.0.sqs_parameters.0.message_group_id").(string); v != "" && !isFIFOQueueARN(arn) {
+			return fmt.Errorf("target.0.sqs_parameters.0.message_group_id can only be specified for an SQS FIFO queue target (%s)", arn)
+		}
+	}
+
+	return nil
+}
+
+// findScheduleByTwoPartKeyWhenNew finds a schedule, retrying while a newly created one is not yet visible.
+func findScheduleByTwoPartKeyWhenNew(ctx context.Context, conn *scheduler.Client, groupName, scheduleName string, isNewResource bool) (*scheduler.GetScheduleOutput, error) {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findScheduleByTwoPartKey(ctx, conn, groupName, scheduleName)
+	}, isNewResource)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*scheduler.GetScheduleOutput), nil
+}
+
+// validateTargetInput returns an error if the specified input is not valid JSON for a target that requires it.
+// Universal targets pass their input to the AWS API action as its request parameters.
+// Lambda functions receive their input verbatim as the invocation payload, which may be any JSON value.
+// Other targets accept text.
+func validateTargetInput(arn, input string) error {
+	if input == "" || validTargetInputJSON(input) {
+		return nil
+	}
+
+	switch {
+	case isUniversalTargetARN(arn):
+		return fmt.Errorf("target.0.input must be a valid JSON document for universal target (%s)", arn)
+	case isLambdaFunctionARN(arn):
+		return fmt.Errorf("target.0.input must be a valid JSON value for Lambda function target (%s)", arn)
+	}
+
+	return nil
+}
+
+// validateTargetSQSParameters returns an error if SQS parameters are specified for a target that is not an SQS queue.
+func validateTargetSQSParameters(arn string, sqsParameters []interface{}) error {
+	if len(sqsParameters) > 0 && !isQueueARN(arn) {
+		return fmt.Errorf("target.0.sqs_parameters can only be specified for an SQS queue target (%s)", arn)
+	}
+
+	return nil
+}
+
 func findScheduleByTwoPartKey(ctx context.Context, conn *scheduler.Client, groupName, scheduleName string) (*scheduler.GetScheduleOutput, error) {
 	in := &scheduler.GetScheduleInput{
 		GroupName: aws.String(groupName),
@@ -700,6 +870,61 @@ func ResourceScheduleParseID(id string) (groupName, scheduleName string, err err
 	return parts[0], parts[1], nil
 }
 
+// normalizeScheduleExpression trims and collapses the whitespace in a schedule expression,
+// e.g. "cron(0  12 * * ? * )" becomes "cron(0 12 * * ? *)".
+func normalizeScheduleExpression(expression string) string {
+	expression = strings.Join(strings.Fields(expression), " ")
+	expression = strings.ReplaceAll(expression, "( ", "(")
+	expression = strings.ReplaceAll(expression, " )", ")")
+
+	return expression
+}
+
+// cronScheduleExpressionTimezoneWarning warns that a cron expression configured without
+// a timezone is evaluated in UTC, which is easily overlooked.
+// The SDK cannot return warnings from CustomizeDiff, so it is reported on create and update.
+func cronScheduleExpressionTimezoneWarning(expression string, timezone cty.Value) diag.Diagnostics {
+	if !strings.HasPrefix(expression, "cron(") || !timezone.IsNull() {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Warning,
+			Summary:       "Cron expression without a timezone",
+			Detail:        fmt.Sprintf("The cron expression %q is evaluated in %s as schedule_expression_timezone is not set.", expression, scheduleExpressionTimezoneDefault),
+			AttributePath: cty.GetAttrPath("schedule_expression_timezone"),
+		},
+	}
+}
+
+// isUniversalTargetARN returns whether the specified target ARN is that of a universal target,
+// i.e. "arn:aws:scheduler:::aws-sdk:service:apiAction".
+func isUniversalTargetARN(arn string) bool {
+	return universalTargetARNRegexp.MatchString(arn)
+}
+
+var universalTargetARNRegexp = regexache.MustCompile(`^arn:[0-9a-z-]+:scheduler:::aws-sdk:`)
+
+// universalTargetDefaultInput is the input sent to a universal target when none is configured.
+// Some AWS API actions reject a request without parameters, even when none are required.
+const universalTargetDefaultInput = "{}"
+
+// normalizeTargetInput returns the canonical (compact) form of a universal target's JSON input.
+// Input to templated targets is returned unchanged.
+func normalizeTargetInput(arn, input string) string {
+	if !isUniversalTargetARN(arn) {
+		return input
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(input)); err != nil {
+		return input
+	}
+
+	return buf.String()
+}
+
 func sagemakerPipelineParameterHash(v interface{}) int {
 	m := v.(map[string]interface{})
 	return create.StringHashcode(fmt.Sprintf("%s-%s", m[names.AttrName].(string), m[names.AttrValue].(string)))
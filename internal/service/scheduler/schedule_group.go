@@ -6,7 +6,9 @@ package scheduler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -35,7 +38,11 @@ func ResourceScheduleGroup() *schema.Resource {
 		DeleteWithoutTimeout: resourceScheduleGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("prevent_destroy_with_schedules", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -80,6 +87,11 @@ func ResourceScheduleGroup() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), `The name must consist of alphanumerics, hyphens, and underscores.`),
 				)),
 			},
+			"prevent_destroy_with_schedules": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -94,6 +106,8 @@ const (
 	ResNameScheduleGroup = "Schedule Group"
 )
 
+var errScheduleGroupNotEmpty = errors.New("schedule group contains schedules")
+
 func resourceScheduleGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
@@ -105,7 +119,7 @@ func resourceScheduleGroupCreate(ctx context.Context, d *schema.ResourceData, me
 		Tags: getTagsIn(ctx),
 	}
 
-	out, err := conn.CreateScheduleGroup(ctx, in)
+	out, err := createScheduleGroup(ctx, conn, in, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionCreating, ResNameScheduleGroup, name, err)
 	}
@@ -123,6 +137,31 @@ func resourceScheduleGroupCreate(ctx context.Context, d *schema.ResourceData, me
 	return append(diags, resourceScheduleGroupRead(ctx, d, meta)...)
 }
 
+// createScheduleGroup creates a schedule group. A group of the same name that is still being deleted,
+// e.g. one that was removed from state while deleting, holds the name until its deletion completes.
+func createScheduleGroup(ctx context.Context, conn *scheduler.Client, in *scheduler.CreateScheduleGroupInput, timeout time.Duration) (*scheduler.CreateScheduleGroupOutput, error) {
+	out, err := conn.CreateScheduleGroup(ctx, in)
+
+	if errs.IsA[*types.ConflictException](err) {
+		name := aws.ToString(in.Name)
+		group, findErr := findScheduleGroupByName(ctx, conn, name)
+
+		switch {
+		case tfresource.NotFound(findErr):
+		case findErr == nil && string(group.State) == scheduleGroupStatusDeleting:
+			if _, err := waitScheduleGroupDeleted(ctx, conn, name, timeout); err != nil {
+				return nil, fmt.Errorf("waiting for previous schedule group deletion: %w", err)
+			}
+		default:
+			return nil, err
+		}
+
+		out, err = conn.CreateScheduleGroup(ctx, in)
+	}
+
+	return out, err
+}
+
 func resourceScheduleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
@@ -139,6 +178,14 @@ func resourceScheduleGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionReading, ResNameScheduleGroup, d.Id(), err)
 	}
 
+	// A group that is being deleted can still be described but its tags can
+	// no longer be listed. Treat it as gone so that it's recreated once deleted, see createScheduleGroup.
+	if !d.IsNewResource() && string(out.State) == scheduleGroupStatusDeleting {
+		log.Printf("[WARN] EventBridge Scheduler Schedule Group (%s) is being deleted, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
 	d.Set(names.AttrARN, out.Arn)
 	d.Set(names.AttrCreationDate, aws.ToTime(out.CreationDate).Format(time.RFC3339))
 	d.Set("last_modification_date", aws.ToTime(out.LastModificationDate).Format(time.RFC3339))
@@ -158,6 +205,23 @@ func resourceScheduleGroupDelete(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
 
+	// Deleting a schedule group also deletes every schedule in it.
+	if d.Get("prevent_destroy_with_schedules").(bool) {
+		scheduleNames, err := findScheduleNamesByGroupName(ctx, conn, d.Id())
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.Scheduler, create.ErrActionDeleting, ResNameScheduleGroup, d.Id(), err)
+		}
+
+		if len(scheduleNames) > 0 {
+			return create.AppendDiagError(diags, names.Scheduler, create.ErrActionDeleting, ResNameScheduleGroup, d.Id(), fmt.Errorf("%w (%d): %s; delete them or set prevent_destroy_with_schedules to false", errScheduleGroupNotEmpty, len(scheduleNames), strings.Join(scheduleNames, ", ")))
+		}
+	}
+
 	log.Printf("[INFO] Deleting EventBridge Scheduler ScheduleGroup %s", d.Id())
 
 	_, err := conn.DeleteScheduleGroup(ctx, &scheduler.DeleteScheduleGroupInput{
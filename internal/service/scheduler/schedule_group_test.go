@@ -18,11 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -103,6 +105,213 @@ func TestAccSchedulerScheduleGroup_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerScheduleGroup_disappearsRecreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var scheduleGroup scheduler.GetScheduleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfscheduler.ResourceScheduleGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccScheduleGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.ScheduleGroupStateActive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerScheduleGroup_deletingRecreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var scheduleGroup scheduler.GetScheduleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleGroupConfig_preventDestroyWithSchedules(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					// The schedule slows down the group's deletion, so the following refresh reads the group as DELETING.
+					testAccCheckScheduleGroupAddSchedule(ctx, resourceName, rName),
+					testAccCheckScheduleGroupStartDeletion(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccScheduleGroupConfig_preventDestroyWithSchedules(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.ScheduleGroupStateActive)),
+				),
+			},
+		},
+	})
+}
+
+func TestCreateScheduleGroup(t *testing.T) {
+	t.Parallel()
+
+	conflict := &types.ConflictException{Message: aws.String("Schedule group test already exists.")}
+	notFound := &types.ResourceNotFoundException{Message: aws.String("Schedule group test does not exist.")}
+
+	testCases := map[string]struct {
+		createErrs          []error
+		getStates           []types.ScheduleGroupState
+		expectedCreateCalls int
+		expectError         bool
+	}{
+		"success": {
+			expectedCreateCalls: 1,
+		},
+		"conflict while deleting": {
+			createErrs:          []error{conflict},
+			getStates:           []types.ScheduleGroupState{types.ScheduleGroupStateDeleting, types.ScheduleGroupStateDeleting},
+			expectedCreateCalls: 2,
+		},
+		"conflict after deletion": {
+			createErrs:          []error{conflict},
+			expectedCreateCalls: 2,
+		},
+		"conflict with active group": {
+			createErrs:          []error{conflict},
+			getStates:           []types.ScheduleGroupState{types.ScheduleGroupStateActive},
+			expectedCreateCalls: 1,
+			expectError:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			// GetScheduleGroup returns each of getStates in turn and then reports the group as not found.
+			var createCalls, getCalls int
+			conn := tfscheduler.NewStubClient(func(params interface{}) (interface{}, error) {
+				switch params.(type) {
+				case *scheduler.CreateScheduleGroupInput:
+					createCalls++
+					if createCalls <= len(testCase.createErrs) {
+						return nil, testCase.createErrs[createCalls-1]
+					}
+
+					return &scheduler.CreateScheduleGroupOutput{ScheduleGroupArn: aws.String("arn")}, nil
+				case *scheduler.GetScheduleGroupInput:
+					getCalls++
+					if getCalls <= len(testCase.getStates) {
+						return &scheduler.GetScheduleGroupOutput{Arn: aws.String("arn"), State: testCase.getStates[getCalls-1]}, nil
+					}
+
+					return nil, notFound
+				}
+
+				return nil, errors.New("unexpected operation")
+			})
+
+			_, err := tfscheduler.CreateScheduleGroup(ctx, conn, &scheduler.CreateScheduleGroupInput{Name: aws.String("test")}, time.Minute)
+
+			if createCalls != testCase.expectedCreateCalls {
+				t.Errorf("got %d CreateScheduleGroup calls, want %d", createCalls, testCase.expectedCreateCalls)
+			}
+
+			if testCase.expectError {
+				if !errors.Is(err, conflict) {
+					t.Errorf("got error %v, want %v", err, conflict)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestAccSchedulerScheduleGroup_preventDestroyWithSchedules(t *testing.T) {
+	ctx := acctest.Context(t)
+	var scheduleGroup scheduler.GetScheduleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleGroupConfig_preventDestroyWithSchedules(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					resource.TestCheckResourceAttr(resourceName, "prevent_destroy_with_schedules", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.ScheduleGroupStateActive)),
+					testAccCheckScheduleGroupAddSchedule(ctx, resourceName, rName),
+				),
+			},
+			{
+				Config:      testAccScheduleGroupConfig_preventDestroyWithSchedulesBase,
+				ExpectError: regexache.MustCompile(`schedule group contains schedules \(1\): ` + rName),
+			},
+			{
+				Config: testAccScheduleGroupConfig_preventDestroyWithSchedules(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					resource.TestCheckResourceAttr(resourceName, "prevent_destroy_with_schedules", acctest.CtFalse),
+				),
+			},
+			{
+				// The schedule created outside of Terraform is deleted with the group.
+				Config: testAccScheduleGroupConfig_preventDestroyWithSchedulesBase,
+			},
+		},
+	})
+}
+
 func TestAccSchedulerScheduleGroup_nameGenerated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var scheduleGroup scheduler.GetScheduleGroupOutput
@@ -124,6 +333,7 @@ func TestAccSchedulerScheduleGroup_nameGenerated(t *testing.T) {
 					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
 					acctest.CheckResourceAttrNameGenerated(resourceName, names.AttrName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, id.UniqueIdPrefix),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.ScheduleGroupStateActive)),
 				),
 			},
 			{
@@ -156,6 +366,7 @@ func TestAccSchedulerScheduleGroup_namePrefix(t *testing.T) {
 					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
 					acctest.CheckResourceAttrNameFromPrefix(resourceName, names.AttrName, "tf-acc-test-prefix-"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.ScheduleGroupStateActive)),
 				),
 			},
 			{
@@ -227,6 +438,67 @@ func TestAccSchedulerScheduleGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerScheduleGroup_tagsDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var scheduleGroup scheduler.GetScheduleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				// Change and add tags out of band and confirm that the drift is detected.
+				PreConfig: func() {
+					testAccScheduleGroupTagResource(ctx, t, &scheduleGroup, map[string]string{
+						acctest.CtKey1: acctest.CtValue1Updated,
+						acctest.CtKey2: acctest.CtValue2,
+					})
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccScheduleGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleGroupExists(ctx, resourceName, &scheduleGroup),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config:   testAccScheduleGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckScheduleGroupDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
@@ -254,6 +526,29 @@ func testAccCheckScheduleGroupDestroy(ctx context.Context) resource.TestCheckFun
 	}
 }
 
+func testAccScheduleGroupTagResource(ctx context.Context, t *testing.T, v *scheduler.GetScheduleGroupOutput, tags map[string]string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
+
+	var apiTags []types.Tag
+	for key, value := range tags {
+		apiTags = append(apiTags, types.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+
+	_, err := conn.TagResource(ctx, &scheduler.TagResourceInput{
+		ResourceArn: v.Arn,
+		Tags:        apiTags,
+	})
+
+	if err != nil {
+		t.Fatalf("tagging Scheduler Schedule Group (%s): %s", aws.ToString(v.Name), err)
+	}
+}
+
 func testAccCheckScheduleGroupExists(ctx context.Context, name string, schedulegroup *scheduler.GetScheduleGroupOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -281,6 +576,55 @@ func testAccCheckScheduleGroupExists(ctx context.Context, name string, scheduleg
 	}
 }
 
+// testAccCheckScheduleGroupStartDeletion deletes the group outside of Terraform without waiting for the deletion to complete.
+func testAccCheckScheduleGroupStartDeletion(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
+
+		_, err := conn.DeleteScheduleGroup(ctx, &scheduler.DeleteScheduleGroupInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+// testAccCheckScheduleGroupAddSchedule creates a schedule in the group outside of Terraform.
+func testAccCheckScheduleGroupAddSchedule(ctx context.Context, name, scheduleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
+
+		input := &scheduler.CreateScheduleInput{
+			FlexibleTimeWindow: &types.FlexibleTimeWindow{
+				Mode: types.FlexibleTimeWindowModeOff,
+			},
+			GroupName:          aws.String(rs.Primary.ID),
+			Name:               aws.String(scheduleName),
+			ScheduleExpression: aws.String("rate(1 hour)"),
+			Target: &types.Target{
+				Arn:     aws.String(s.RootModule().Resources["aws_sqs_queue.test"].Primary.Attributes[names.AttrARN]),
+				RoleArn: aws.String(s.RootModule().Resources["aws_iam_role.test"].Primary.Attributes[names.AttrARN]),
+			},
+		}
+
+		_, err := tfresource.RetryWhenIsAErrorMessageContains[*types.ValidationException](ctx, 2*time.Minute, func() (interface{}, error) {
+			return conn.CreateSchedule(ctx, input)
+		}, "The execution role you provide must allow AWS EventBridge Scheduler to assume the role.")
+
+		return err
+	}
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SchedulerClient(ctx)
 
@@ -340,3 +684,19 @@ resource "aws_scheduler_schedule_group" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+const testAccScheduleGroupConfig_preventDestroyWithSchedulesBase = testAccScheduleConfig_base + `
+resource "aws_sqs_queue" "test" {}
+`
+
+func testAccScheduleGroupConfig_preventDestroyWithSchedules(rName string, preventDestroyWithSchedules bool) string {
+	return acctest.ConfigCompose(
+		testAccScheduleGroupConfig_preventDestroyWithSchedulesBase,
+		fmt.Sprintf(`
+resource "aws_scheduler_schedule_group" "test" {
+  name                           = %[1]q
+  prevent_destroy_with_schedules = %[2]t
+}
+`, rName, preventDestroyWithSchedules),
+	)
+}
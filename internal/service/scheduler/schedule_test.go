@@ -8,12 +8,19 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -178,6 +185,164 @@ func TestResourceScheduleParseID(t *testing.T) {
 	}
 }
 
+func TestNormalizeScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Expression string
+		Expected   string
+	}{
+		{
+			Expression: "rate(1 hour)",
+			Expected:   "rate(1 hour)",
+		},
+		{
+			Expression: " rate(1  hour) ",
+			Expected:   "rate(1 hour)",
+		},
+		{
+			Expression: "cron(0  12 *\t* ? * )",
+			Expected:   "cron(0 12 * * ? *)",
+		},
+		{
+			Expression: "at( 2022-11-20T13:00:00)",
+			Expected:   "at(2022-11-20T13:00:00)",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Expression, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfscheduler.NormalizeScheduleExpression(tc.Expression), tc.Expected; got != want {
+				t.Errorf("expected %s, got: %s", want, got)
+			}
+		})
+	}
+}
+
+func TestCronScheduleExpressionTimezoneWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Expression string
+		Timezone   cty.Value
+		Expected   int
+	}{
+		{
+			Expression: "cron(0 8 * * ? *)",
+			Timezone:   cty.NullVal(cty.String),
+			Expected:   1,
+		},
+		{
+			Expression: "cron(0 8 * * ? *)",
+			Timezone:   cty.StringVal("UTC"),
+		},
+		{
+			Expression: "rate(1 hour)",
+			Timezone:   cty.NullVal(cty.String),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Expression, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tfscheduler.CronScheduleExpressionTimezoneWarning(tc.Expression, tc.Timezone)
+
+			if got, want := len(diags), tc.Expected; got != want {
+				t.Fatalf("expected %d diagnostics, got: %d", want, got)
+			}
+
+			for _, d := range diags {
+				if d.Severity != diag.Warning {
+					t.Errorf("expected warning, got: %v", d.Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeTargetInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		ARN      string
+		Input    string
+		Expected string
+	}{
+		{
+			ARN:      "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			Input:    "{ \"MessageBody\" : \"test\",\n  \"QueueUrl\": \"url\" }",
+			Expected: `{"MessageBody":"test","QueueUrl":"url"}`,
+		},
+		{
+			ARN:      "arn:aws-us-gov:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			Input:    `{"MessageBody": "test"}`,
+			Expected: `{"MessageBody":"test"}`,
+		},
+		{
+			ARN:      "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			Input:    `not JSON`,
+			Expected: `not JSON`,
+		},
+		{
+			ARN:      "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			Input:    `{"MessageBody": "test"}`,
+			Expected: `{"MessageBody": "test"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.ARN, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfscheduler.NormalizeTargetInput(tc.ARN, tc.Input), tc.Expected; got != want {
+				t.Errorf("expected %s, got: %s", want, got)
+			}
+		})
+	}
+}
+
+func TestValidateTargetSQSParameters(t *testing.T) {
+	t.Parallel()
+
+	sqsParameters := []interface{}{map[string]interface{}{"message_group_id": ""}}
+
+	testCases := []struct {
+		ARN           string
+		SQSParameters []interface{}
+		ExpectError   bool
+	}{
+		{
+			ARN:           "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			SQSParameters: sqsParameters,
+		},
+		{
+			ARN:           "arn:aws:lambda:us-west-2:123456789012:function:test", //lintignore:AWSAT003,AWSAT005
+			SQSParameters: sqsParameters,
+			ExpectError:   true,
+		},
+		{
+			ARN: "arn:aws:lambda:us-west-2:123456789012:function:test", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.ARN, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tfscheduler.ValidateTargetSQSParameters(tc.ARN, tc.SQSParameters); (err != nil) != tc.ExpectError {
+				t.Errorf("expected error %t, got: %v", tc.ExpectError, err)
+			}
+		})
+	}
+}
+
 func TestAccSchedulerSchedule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -237,6 +402,67 @@ func TestAccSchedulerSchedule_basic(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_minimal(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_minimal(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					testAccCheckScheduleFlexibleTimeWindowMode(&schedule, types.FlexibleTimeWindowModeOff),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "OFF"),
+				),
+			},
+			{
+				Config:   testAccScheduleConfig_minimal(name),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_minimalFlexibleTimeWindow(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					testAccCheckScheduleFlexibleTimeWindowMode(&schedule, types.FlexibleTimeWindowModeFlexible),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "FLEXIBLE"),
+				),
+			},
+			{
+				// Removing the block reverts the window to OFF.
+				Config: testAccScheduleConfig_minimal(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					testAccCheckScheduleFlexibleTimeWindowMode(&schedule, types.FlexibleTimeWindowModeOff),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "OFF"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -269,6 +495,38 @@ func TestAccSchedulerSchedule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_disappears_scheduleGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_groupName(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfscheduler.ResourceScheduleGroup(), "aws_scheduler_schedule_group.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -452,6 +710,36 @@ func TestAccSchedulerSchedule_flexibleTimeWindow(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_flexibleTimeWindowInvalidMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_flexibleTimeWindowMode(name, "FLEXABLE"),
+				ExpectError: regexache.MustCompile(`expected mode to be one of`),
+			},
+			{
+				Config:      testAccScheduleConfig_flexibleTimeWindowMode(name, "off"),
+				ExpectError: regexache.MustCompile(`expected mode to be one of`),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_groupName(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -488,6 +776,32 @@ func TestAccSchedulerSchedule_groupName(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_groupNameNonexistent(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_groupNameNonexistent(name),
+				ExpectError: regexache.MustCompile(fmt.Sprintf(`schedule group \(%s\) does not exist`, name)),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_kmsKeyARN(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -548,13 +862,14 @@ func TestAccSchedulerSchedule_kmsKeyARN(t *testing.T) {
 	})
 }
 
-func TestAccSchedulerSchedule_nameGenerated(t *testing.T) {
+func TestAccSchedulerSchedule_kmsKeyARNAddRemove(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
 	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_scheduler_schedule.test"
 
 	acctest.ParallelTest(ctx, t, resource.TestCase{
@@ -568,29 +883,53 @@ func TestAccSchedulerSchedule_nameGenerated(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_nameGenerated(),
+				Config: testAccScheduleConfig_basic(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					acctest.CheckResourceAttrNameGenerated(resourceName, names.AttrName),
-					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, id.UniqueIdPrefix),
+					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyARN, ""),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccScheduleConfig_kmsKeyARN(name, 0),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyARN, "aws_kms_key.test.0", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccScheduleConfig_basic(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyARN, ""),
+				),
+			},
+			{
+				Config:   testAccScheduleConfig_basic(name),
+				PlanOnly: true,
 			},
 		},
 	})
 }
 
-func TestAccSchedulerSchedule_namePrefix(t *testing.T) {
+func TestAccSchedulerSchedule_lastModificationDate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
 	var schedule scheduler.GetScheduleOutput
+	var lastModificationDate string
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_scheduler_schedule.test"
 
 	acctest.ParallelTest(ctx, t, resource.TestCase{
@@ -604,15 +943,112 @@ func TestAccSchedulerSchedule_namePrefix(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_namePrefix("tf-acc-test-prefix-"),
+				Config: testAccScheduleConfig_basic(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					acctest.CheckResourceAttrNameFromPrefix(resourceName, names.AttrName, "tf-acc-test-prefix-"),
-					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttrWith(resourceName, "last_modification_date", func(value string) error {
+						if value == "" {
+							return errors.New("last_modification_date not set")
+						}
+						lastModificationDate = value
+						return nil
+					}),
 				),
 			},
 			{
-				ResourceName:      resourceName,
+				// Modify the schedule out of band and confirm that the change is visible after refresh.
+				PreConfig: func() {
+					time.Sleep(2 * time.Second)
+					testAccScheduleUpdateDescription(ctx, t, &schedule, "modified out of band")
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "modified out of band"),
+					resource.TestCheckResourceAttrWith(resourceName, "last_modification_date", func(value string) error {
+						if value == lastModificationDate {
+							return fmt.Errorf("last_modification_date not changed: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccScheduleConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_nameGenerated(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_nameGenerated(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					acctest.CheckResourceAttrNameGenerated(resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, id.UniqueIdPrefix),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_namePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_namePrefix("tf-acc-test-prefix-"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					acctest.CheckResourceAttrNameFromPrefix(resourceName, names.AttrName, "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, "tf-acc-test-prefix-"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
@@ -620,6 +1056,28 @@ func TestAccSchedulerSchedule_namePrefix(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_nameAndNamePrefixConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_nameAndNamePrefix(name, "tf-acc-test-prefix-"),
+				ExpectError: regexache.MustCompile(`"name": conflicts with name_prefix`),
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_scheduleExpression(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -668,6 +1126,85 @@ func TestAccSchedulerSchedule_scheduleExpression(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_scheduleExpressionWhitespace(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_scheduleExpression(name, "cron(0  12 *  * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+				),
+			},
+			{
+				Config:   testAccScheduleConfig_scheduleExpression(name, "cron(0  12 *  * ? *)"),
+				PlanOnly: true,
+			},
+			{
+				Config:   testAccScheduleConfig_scheduleExpression(name, "cron(0 12 * * ? *)"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_scheduleExpressionCronDefaultTimezone(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_scheduleExpression(name, "cron(0 8 * * ? *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, names.AttrScheduleExpression, "cron(0 8 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_expression_timezone", "UTC"),
+				),
+			},
+			{
+				Config:   testAccScheduleConfig_scheduleExpression(name, "cron(0 8 * * ? *)"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_scheduleExpressionTimezone(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -848,7 +1385,63 @@ func TestAccSchedulerSchedule_state(t *testing.T) {
 	})
 }
 
-func TestAccSchedulerSchedule_targetARN(t *testing.T) {
+func TestAccSchedulerSchedule_stateInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_state(name, "ENABLE"),
+				ExpectError: regexache.MustCompile(`expected state to be one of`),
+			},
+			{
+				Config:      testAccScheduleConfig_state(name, "disabled"),
+				ExpectError: regexache.MustCompile(`expected state to be one of`),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_descriptionTooLong(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_description(name, strings.Repeat("a", 513)),
+				ExpectError: regexache.MustCompile(`expected length of description to be in the range \(0 - 512\)`),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_stateOnlyUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -869,22 +1462,38 @@ func TestAccSchedulerSchedule_targetARN(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_targetARN(name, 0),
+				Config: testAccScheduleConfig_stateOnlyUpdate(name, "ENABLED"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-			{
-				Config: testAccScheduleConfig_targetARN(name, 1),
+				Config: testAccScheduleConfig_stateOnlyUpdate(name, "DISABLED"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "DISABLED"),
+					func(s *terraform.State) error {
+						if got, want := schedule.State, types.ScheduleStateDisabled; got != want {
+							return fmt.Errorf("state = %s, want %s", got, want)
+						}
+						if got, want := aws.ToString(schedule.Description), "state only"; got != want {
+							return fmt.Errorf("description = %q, want %q", got, want)
+						}
+						if got, want := schedule.FlexibleTimeWindow.Mode, types.FlexibleTimeWindowModeFlexible; got != want {
+							return fmt.Errorf("flexible_time_window.mode = %s, want %s", got, want)
+						}
+						if got, want := aws.ToInt32(schedule.FlexibleTimeWindow.MaximumWindowInMinutes), int32(10); got != want {
+							return fmt.Errorf("flexible_time_window.maximum_window_in_minutes = %d, want %d", got, want)
+						}
+						if schedule.Target == nil || aws.ToString(schedule.Target.Input) != "test" {
+							return errors.New("target was not preserved")
+						}
+						if got, want := aws.ToInt32(schedule.Target.RetryPolicy.MaximumRetryAttempts), int32(3); got != want {
+							return fmt.Errorf("target.retry_policy.maximum_retry_attempts = %d, want %d", got, want)
+						}
+						return nil
+					},
 				),
 			},
 			{
@@ -896,7 +1505,7 @@ func TestAccSchedulerSchedule_targetARN(t *testing.T) {
 	})
 }
 
-func TestAccSchedulerSchedule_targetDeadLetterConfig(t *testing.T) {
+func TestAccSchedulerSchedule_targetARN(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -917,22 +1526,10 @@ func TestAccSchedulerSchedule_targetDeadLetterConfig(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_targetDeadLetterConfig(name, 0),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttrPair(resourceName, "target.0.dead_letter_config.0.arn", "aws_sqs_queue.dlq.0", names.AttrARN),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-			{
-				Config: testAccScheduleConfig_targetDeadLetterConfig(name, 1),
+				Config: testAccScheduleConfig_targetARN(name, 0),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttrPair(resourceName, "target.0.dead_letter_config.0.arn", "aws_sqs_queue.dlq.1", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test.0", names.AttrARN),
 				),
 			},
 			{
@@ -941,10 +1538,10 @@ func TestAccSchedulerSchedule_targetDeadLetterConfig(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccScheduleConfig_basic(name),
+				Config: testAccScheduleConfig_targetARN(name, 1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttr(resourceName, "target.0.dead_letter_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test.1", names.AttrARN),
 				),
 			},
 			{
@@ -956,7 +1553,7 @@ func TestAccSchedulerSchedule_targetDeadLetterConfig(t *testing.T) {
 	})
 }
 
-func TestAccSchedulerSchedule_targetECSParameters(t *testing.T) {
+func TestAccSchedulerSchedule_targetARNLambdaInPlace(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -977,7 +1574,145 @@ func TestAccSchedulerSchedule_targetECSParameters(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_targetECSParameters1(name),
+				Config: testAccScheduleConfig_targetARNLambda(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_lambda_function.test.0", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccScheduleConfig_targetARNLambda(name, 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_lambda_function.test.1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetDeadLetterConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetDeadLetterConfig(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.dead_letter_config.0.arn", "aws_sqs_queue.dlq.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_targetDeadLetterConfig(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.dead_letter_config.0.arn", "aws_sqs_queue.dlq.1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.dead_letter_config.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetDeadLetterConfigInvalidARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_targetDeadLetterConfigARN(name, "arn:${data.aws_partition.main.partition}:sqs:::dlq"),
+				ExpectError: regexache.MustCompile(`must be the ARN of an SQS queue`),
+			},
+			{
+				Config:      testAccScheduleConfig_targetDeadLetterConfigARN(name, "arn:${data.aws_partition.main.partition}:sns::${data.aws_caller_identity.main.account_id}:dlq"),
+				ExpectError: regexache.MustCompile(`must be the ARN of an SQS queue`),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetECSParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetECSParameters1(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
 					resource.TestCheckResourceAttr(resourceName, "target.0.ecs_parameters.0.capacity_provider_strategy.#", acctest.Ct0),
@@ -1131,6 +1866,44 @@ func TestAccSchedulerSchedule_targetECSParameters(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_targetECSParametersContainerOverrides(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetECSParametersContainerOverrides(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.ecs_parameters.0.group", "my-task-group"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.ecs_parameters.0.task_count", acctest.Ct2),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "target.0.input", `{"containerOverrides":[{"command":["echo","hello"],"name":"first"}]}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSchedulerSchedule_targetEventBridgeParameters(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1259,15 +2032,14 @@ func TestAccSchedulerSchedule_targetInput(t *testing.T) {
 	})
 }
 
-func TestAccSchedulerSchedule_targetKinesisParameters(t *testing.T) {
+func TestAccSchedulerSchedule_targetInputOmitted(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
 	}
 
 	var schedule scheduler.GetScheduleOutput
-	scheduleName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
-	streamName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_scheduler_schedule.test"
 
 	acctest.ParallelTest(ctx, t, resource.TestCase{
@@ -1281,35 +2053,16 @@ func TestAccSchedulerSchedule_targetKinesisParameters(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_targetKinesisParameters(scheduleName, streamName, "test-1"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.0.partition_key", "test-1"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-			{
-				Config: testAccScheduleConfig_targetKinesisParameters(scheduleName, streamName, "test-2"),
+				Config: testAccScheduleConfig_basic(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.0.partition_key", "test-2"),
+					resource.TestCheckResourceAttr(resourceName, "target.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", ""),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-			{
-				Config: testAccScheduleConfig_basic(scheduleName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.#", acctest.Ct0),
-				),
+				Config:   testAccScheduleConfig_basic(name),
+				PlanOnly: true,
 			},
 			{
 				ResourceName:      resourceName,
@@ -1320,7 +2073,7 @@ func TestAccSchedulerSchedule_targetKinesisParameters(t *testing.T) {
 	})
 }
 
-func TestAccSchedulerSchedule_targetRetryPolicy(t *testing.T) {
+func TestAccSchedulerSchedule_targetInputCanonicalization(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1341,39 +2094,55 @@ func TestAccSchedulerSchedule_targetRetryPolicy(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_targetRetryPolicy(name, 60, 1),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "60"),
-					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", acctest.Ct1),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config:      testAccScheduleConfig_targetInputRaw(name, `{"MessageBody": "test1",`),
+				ExpectError: regexache.MustCompile(`must be a valid JSON document for universal target`),
 			},
 			{
-				Config: testAccScheduleConfig_targetRetryPolicy(name, 61, 0),
+				Config: testAccScheduleConfig_targetInputRaw(name, `{ "MessageBody" : "test1",  "QueueUrl" : "${aws_sqs_queue.test.url}" }`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "61"),
-					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", acctest.Ct0),
+					resource.TestMatchResourceAttr(resourceName, "target.0.input", regexache.MustCompile(`^\{"MessageBody":"test1","QueueUrl":"[^"]+"\}$`)),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config:   testAccScheduleConfig_targetInputRaw(name, `{"QueueUrl": "${aws_sqs_queue.test.url}", "MessageBody": "test1"}`),
+				PlanOnly: true,
 			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetInputOmittedUniversal(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_basic(name),
+				Config: testAccScheduleConfig_targetInputOmittedUniversal(name),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "86400"),
-					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", "185"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", "{}"),
 				),
 			},
+			{
+				Config:   testAccScheduleConfig_targetInputOmittedUniversal(name),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -1383,7 +2152,7 @@ func TestAccSchedulerSchedule_targetRetryPolicy(t *testing.T) {
 	})
 }
 
-func TestAccSchedulerSchedule_targetRoleARN(t *testing.T) {
+func TestAccSchedulerSchedule_targetInputLambda(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1404,22 +2173,28 @@ func TestAccSchedulerSchedule_targetRoleARN(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_targetRoleARN(name, "test"),
+				Config:      testAccScheduleConfig_targetInputLambda(name, `{"key": `),
+				ExpectError: regexache.MustCompile(`must be a valid JSON value for Lambda function target`),
+			},
+			{
+				Config: testAccScheduleConfig_targetInputLambda(name, `{ "key" : "value" }`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", `{ "key" : "value" }`),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccScheduleConfig_targetInputLambda(name, `[1, 2]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", `[1, 2]`),
+				),
 			},
 			{
-				Config: testAccScheduleConfig_targetRoleARN(name, "test1"),
+				Config: testAccScheduleConfig_targetInputLambda(name, `"<aws.scheduler.execution-id>"`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", `"<aws.scheduler.execution-id>"`),
 				),
 			},
 			{
@@ -1431,7 +2206,7 @@ func TestAccSchedulerSchedule_targetRoleARN(t *testing.T) {
 	})
 }
 
-func TestAccSchedulerSchedule_targetSageMakerPipelineParameters(t *testing.T) {
+func TestAccSchedulerSchedule_targetInputSQSText(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1452,14 +2227,332 @@ func TestAccSchedulerSchedule_targetSageMakerPipelineParameters(t *testing.T) {
 		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccScheduleConfig_targetSageMakerPipelineParameters1(name, acctest.CtKey1, acctest.CtValue1),
+				// The queue's ARN is not known when the plan is checked.
+				Config: testAccScheduleConfig_targetInputSQS(name, `[x]`),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
-					resource.TestCheckResourceAttr(resourceName, "target.0.sagemaker_pipeline_parameters.0.pipeline_parameter.#", acctest.Ct1),
-					resource.TestCheckTypeSetElemNestedAttrs(
-						resourceName, "target.0.sagemaker_pipeline_parameters.0.pipeline_parameter.*",
-						map[string]string{
-							names.AttrName:  acctest.CtKey1,
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", `[x]`),
+				),
+			},
+			{
+				Config: testAccScheduleConfig_targetInputSQS(name, `{not json`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.input", `{not json`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetInputContextAttributes(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetInputRaw(name, `{"MessageBody": "<aws.scheduler.execution-id>", "QueueUrl": "${aws_sqs_queue.test.url}"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestMatchResourceAttr(resourceName, "target.0.input", regexache.MustCompile(`"MessageBody":"<aws.scheduler.execution-id>"`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetKinesisParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	scheduleName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	streamName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetKinesisParameters(scheduleName, streamName, "test-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.0.partition_key", "test-1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_targetKinesisParameters(scheduleName, streamName, "test-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.0.partition_key", "test-2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_basic(scheduleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.kinesis_parameters.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetRetryPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetRetryPolicy(name, 60, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_targetRetryPolicy(name, 61, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "61"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_event_age_in_seconds", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.retry_policy.0.maximum_retry_attempts", "185"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetRoleARN(name, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_targetRoleARN(name, "test1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrScheduleExpression, "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, "flexible_time_window.0.mode", "OFF"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetRoleARNDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	var roleARN string
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetRoleARN(name, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttrWith("aws_iam_role.test1", names.AttrARN, func(value string) error {
+						roleARN = value
+						return nil
+					}),
+				),
+			},
+			{
+				// Change the target's role out of band and confirm that the drift is detected.
+				PreConfig: func() {
+					testAccScheduleUpdateTargetRoleARN(ctx, t, &schedule, roleARN)
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccScheduleConfig_targetRoleARN(name, "test"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetSageMakerPipelineParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_targetSageMakerPipelineParameters1(name, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.sagemaker_pipeline_parameters.0.pipeline_parameter.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						resourceName, "target.0.sagemaker_pipeline_parameters.0.pipeline_parameter.*",
+						map[string]string{
+							names.AttrName:  acctest.CtKey1,
 							names.AttrValue: acctest.CtValue1,
 						}),
 				),
@@ -1587,93 +2680,417 @@ func TestAccSchedulerSchedule_targetSQSParameters(t *testing.T) {
 	})
 }
 
-func testAccCheckScheduleDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+func TestAccSchedulerSchedule_targetSQSParametersNonFIFOQueue(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_targetSQSParametersQueueName(name, name, false),
+				ExpectError: regexache.MustCompile(`message_group_id can only be specified for an SQS FIFO queue target`),
+			},
+			{
+				Config: testAccScheduleConfig_targetSQSParametersQueueName(name, name+".fifo", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttr(resourceName, "target.0.sqs_parameters.0.message_group_id", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulerSchedule_targetSQSParametersNonSQSTarget(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_targetSQSParametersLambda(name),
+				ExpectError: regexache.MustCompile(`sqs_parameters can only be specified for an SQS queue target`),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduleDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_scheduler_schedule" {
+				continue
+			}
+
+			groupName, scheduleName, err := tfscheduler.ResourceScheduleParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfscheduler.FindScheduleByTwoPartKey(ctx, conn, groupName, scheduleName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("%s %s %s still exists", names.Scheduler, tfscheduler.ResNameSchedule, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccScheduleUpdateDescription(ctx context.Context, t *testing.T, v *scheduler.GetScheduleOutput, description string) {
+	t.Helper()
+
+	conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+
+	_, err := conn.UpdateSchedule(ctx, &scheduler.UpdateScheduleInput{
+		Description:                aws.String(description),
+		EndDate:                    v.EndDate,
+		FlexibleTimeWindow:         v.FlexibleTimeWindow,
+		GroupName:                  v.GroupName,
+		KmsKeyArn:                  v.KmsKeyArn,
+		Name:                       v.Name,
+		ScheduleExpression:         v.ScheduleExpression,
+		ScheduleExpressionTimezone: v.ScheduleExpressionTimezone,
+		StartDate:                  v.StartDate,
+		State:                      v.State,
+		Target:                     v.Target,
+	})
+
+	if err != nil {
+		t.Fatalf("updating Scheduler Schedule (%s): %s", aws.ToString(v.Name), err)
+	}
+}
+
+func testAccScheduleUpdateTargetRoleARN(ctx context.Context, t *testing.T, v *scheduler.GetScheduleOutput, roleARN string) {
+	t.Helper()
+
+	conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+
+	target := *v.Target
+	target.RoleArn = aws.String(roleARN)
+
+	_, err := conn.UpdateSchedule(ctx, &scheduler.UpdateScheduleInput{
+		Description:                v.Description,
+		EndDate:                    v.EndDate,
+		FlexibleTimeWindow:         v.FlexibleTimeWindow,
+		GroupName:                  v.GroupName,
+		KmsKeyArn:                  v.KmsKeyArn,
+		Name:                       v.Name,
+		ScheduleExpression:         v.ScheduleExpression,
+		ScheduleExpressionTimezone: v.ScheduleExpressionTimezone,
+		StartDate:                  v.StartDate,
+		State:                      v.State,
+		Target:                     &target,
+	})
+
+	if err != nil {
+		t.Fatalf("updating Scheduler Schedule (%s): %s", aws.ToString(v.Name), err)
+	}
+}
+
+func testAccCheckScheduleExists(ctx context.Context, t *testing.T, name string, v *scheduler.GetScheduleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Scheduler, create.ErrActionCheckingExistence, tfscheduler.ResNameSchedule, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Scheduler, create.ErrActionCheckingExistence, tfscheduler.ResNameSchedule, name, errors.New("not set"))
+		}
+
+		groupName, scheduleName, err := tfscheduler.ResourceScheduleParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+
+		output, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, groupName, scheduleName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckScheduleFlexibleTimeWindowMode(v *scheduler.GetScheduleOutput, mode types.FlexibleTimeWindowMode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.FlexibleTimeWindow == nil {
+			return errors.New("flexible time window not set")
+		}
+
+		if got := v.FlexibleTimeWindow.Mode; got != mode {
+			return fmt.Errorf("flexible time window mode is %s, want %s", got, mode)
+		}
+
+		return nil
+	}
+}
+
+const testAccScheduleConfig_base = `
+data "aws_caller_identity" "main" {}
+data "aws_partition" "main" {}
+
+resource "aws_iam_role" "test" {
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = {
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "scheduler.${data.aws_partition.main.dns_suffix}"
+      }
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" : data.aws_caller_identity.main.account_id
+        }
+      }
+    }
+  })
+}
+`
+
+func testAccScheduleConfig_basic(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name),
+	)
+}
+
+func testAccScheduleConfig_minimal(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name),
+	)
+}
+
+func testAccScheduleConfig_minimalFlexibleTimeWindow(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    maximum_window_in_minutes = 10
+    mode                      = "FLEXIBLE"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name),
+	)
+}
+
+func testAccScheduleConfig_description(name, description string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  description = %[2]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, description),
+	)
+}
+
+func testAccScheduleConfig_endDate(name, endDate string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
 
-		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_scheduler_schedule" {
-				continue
-			}
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
 
-			groupName, scheduleName, err := tfscheduler.ResourceScheduleParseID(rs.Primary.ID)
+  end_date = %[2]q
 
-			if err != nil {
-				return err
-			}
+  flexible_time_window {
+    mode = "OFF"
+  }
 
-			_, err = tfscheduler.FindScheduleByTwoPartKey(ctx, conn, groupName, scheduleName)
+  schedule_expression = "rate(1 hour)"
 
-			if tfresource.NotFound(err) {
-				continue
-			}
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, endDate),
+	)
+}
 
-			if err != nil {
-				return err
-			}
+func testAccScheduleConfig_flexibleTimeWindow(name string, window int) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
 
-			return fmt.Errorf("%s %s %s still exists", names.Scheduler, tfscheduler.ResNameSchedule, rs.Primary.ID)
-		}
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
 
-		return nil
-	}
+  flexible_time_window {
+    maximum_window_in_minutes = %[2]d
+    mode                      = "FLEXIBLE"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, window),
+	)
 }
 
-func testAccCheckScheduleExists(ctx context.Context, t *testing.T, name string, v *scheduler.GetScheduleOutput) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[name]
-		if !ok {
-			return create.Error(names.Scheduler, create.ErrActionCheckingExistence, tfscheduler.ResNameSchedule, name, errors.New("not found"))
-		}
+func testAccScheduleConfig_flexibleTimeWindowMode(name, mode string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
 
-		if rs.Primary.ID == "" {
-			return create.Error(names.Scheduler, create.ErrActionCheckingExistence, tfscheduler.ResNameSchedule, name, errors.New("not set"))
-		}
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
 
-		groupName, scheduleName, err := tfscheduler.ResourceScheduleParseID(rs.Primary.ID)
+  flexible_time_window {
+    mode = %[2]q
+  }
 
-		if err != nil {
-			return err
-		}
+  schedule_expression = "rate(1 hour)"
 
-		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, mode),
+	)
+}
 
-		output, err := tfscheduler.FindScheduleByTwoPartKey(ctx, conn, groupName, scheduleName)
+func testAccScheduleConfig_groupName(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
 
-		if err != nil {
-			return err
-		}
+resource "aws_scheduler_schedule_group" "test" {}
 
-		*v = *output
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
 
-		return nil
-	}
-}
+  flexible_time_window {
+    mode = "OFF"
+  }
 
-const testAccScheduleConfig_base = `
-data "aws_caller_identity" "main" {}
-data "aws_partition" "main" {}
+  group_name = aws_scheduler_schedule_group.test.name
 
-resource "aws_iam_role" "test" {
-  assume_role_policy = jsonencode({
-    Version = "2012-10-17"
-    Statement = {
-      Effect = "Allow"
-      Action = "sts:AssumeRole"
-      Principal = {
-        Service = "scheduler.${data.aws_partition.main.dns_suffix}"
-      }
-      Condition = {
-        StringEquals = {
-          "aws:SourceAccount" : data.aws_caller_identity.main.account_id
-        }
-      }
-    }
-  })
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name),
+	)
 }
-`
 
-func testAccScheduleConfig_basic(name string) string {
+func testAccScheduleConfig_groupNameNonexistent(name string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
@@ -1686,6 +3103,8 @@ resource "aws_scheduler_schedule" "test" {
     mode = "OFF"
   }
 
+  group_name = %[1]q
+
   schedule_expression = "rate(1 hour)"
 
   target {
@@ -1697,17 +3116,43 @@ resource "aws_scheduler_schedule" "test" {
 	)
 }
 
-func testAccScheduleConfig_description(name, description string) string {
+func testAccScheduleConfig_kmsKeyARN(name string, index int) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {}
 
+resource "aws_kms_key" "test" {
+  count = 2
+}
+
 resource "aws_scheduler_schedule" "test" {
   name = %[1]q
 
-  description = %[2]q
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  kms_key_arn = aws_kms_key.test[%[2]d].arn
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, index),
+	)
+}
+
+func testAccScheduleConfig_nameGenerated() string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		`
+resource "aws_sqs_queue" "test" {}
 
+resource "aws_scheduler_schedule" "test" {
   flexible_time_window {
     mode = "OFF"
   }
@@ -1719,20 +3164,18 @@ resource "aws_scheduler_schedule" "test" {
     role_arn = aws_iam_role.test.arn
   }
 }
-`, name, description),
+`,
 	)
 }
 
-func testAccScheduleConfig_endDate(name, endDate string) string {
+func testAccScheduleConfig_namePrefix(namePrefix string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {}
 
 resource "aws_scheduler_schedule" "test" {
-  name = %[1]q
-
-  end_date = %[2]q
+  name_prefix = %[1]q
 
   flexible_time_window {
     mode = "OFF"
@@ -1745,22 +3188,22 @@ resource "aws_scheduler_schedule" "test" {
     role_arn = aws_iam_role.test.arn
   }
 }
-`, name, endDate),
+`, namePrefix),
 	)
 }
 
-func testAccScheduleConfig_flexibleTimeWindow(name string, window int) string {
+func testAccScheduleConfig_nameAndNamePrefix(name, namePrefix string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {}
 
 resource "aws_scheduler_schedule" "test" {
-  name = %[1]q
+  name        = %[1]q
+  name_prefix = %[2]q
 
   flexible_time_window {
-    maximum_window_in_minutes = %[2]d
-    mode                      = "FLEXIBLE"
+    mode = "OFF"
   }
 
   schedule_expression = "rate(1 hour)"
@@ -1770,17 +3213,39 @@ resource "aws_scheduler_schedule" "test" {
     role_arn = aws_iam_role.test.arn
   }
 }
-`, name, window),
+`, name, namePrefix),
 	)
 }
 
-func testAccScheduleConfig_groupName(name string) string {
+func testAccScheduleConfig_scheduleExpression(name, expression string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {}
 
-resource "aws_scheduler_schedule_group" "test" {}
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = %[2]q
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, expression),
+	)
+}
+
+func testAccScheduleConfig_scheduleExpressionTimezone(name, timezone string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
 
 resource "aws_scheduler_schedule" "test" {
   name = %[1]q
@@ -1789,29 +3254,51 @@ resource "aws_scheduler_schedule" "test" {
     mode = "OFF"
   }
 
-  group_name = aws_scheduler_schedule_group.test.name
+  schedule_expression = "rate(1 hour)"
+
+  schedule_expression_timezone = %[2]q
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name, timezone),
+	)
+}
+
+func testAccScheduleConfig_startDate(name, startDate string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
 
   schedule_expression = "rate(1 hour)"
 
+  start_date = %[2]q
+
   target {
     arn      = aws_sqs_queue.test.arn
     role_arn = aws_iam_role.test.arn
   }
 }
-`, name),
+`, name, startDate),
 	)
 }
 
-func testAccScheduleConfig_kmsKeyARN(name string, index int) string {
+func testAccScheduleConfig_state(name, state string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {}
 
-resource "aws_kms_key" "test" {
-  count = 2
-}
-
 resource "aws_scheduler_schedule" "test" {
   name = %[1]q
 
@@ -1819,49 +3306,62 @@ resource "aws_scheduler_schedule" "test" {
     mode = "OFF"
   }
 
-  kms_key_arn = aws_kms_key.test[%[2]d].arn
-
   schedule_expression = "rate(1 hour)"
 
+  state = %[2]q
+
   target {
     arn      = aws_sqs_queue.test.arn
     role_arn = aws_iam_role.test.arn
   }
 }
-`, name, index),
+`, name, state),
 	)
 }
 
-func testAccScheduleConfig_nameGenerated() string {
+func testAccScheduleConfig_stateOnlyUpdate(name, state string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
-		`
+		fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {}
 
 resource "aws_scheduler_schedule" "test" {
+  name        = %[1]q
+  description = "state only"
+
   flexible_time_window {
-    mode = "OFF"
+    maximum_window_in_minutes = 10
+    mode                      = "FLEXIBLE"
   }
 
   schedule_expression = "rate(1 hour)"
 
+  state = %[2]q
+
   target {
     arn      = aws_sqs_queue.test.arn
     role_arn = aws_iam_role.test.arn
+    input    = "test"
+
+    retry_policy {
+      maximum_retry_attempts = 3
+    }
   }
 }
-`,
+`, name, state),
 	)
 }
 
-func testAccScheduleConfig_namePrefix(namePrefix string) string {
+func testAccScheduleConfig_targetARN(name string, i int) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
-resource "aws_sqs_queue" "test" {}
+resource "aws_sqs_queue" "test" {
+  count = 2
+}
 
 resource "aws_scheduler_schedule" "test" {
-  name_prefix = %[1]q
+  name = %[1]q
 
   flexible_time_window {
     mode = "OFF"
@@ -1870,19 +3370,42 @@ resource "aws_scheduler_schedule" "test" {
   schedule_expression = "rate(1 hour)"
 
   target {
-    arn      = aws_sqs_queue.test.arn
+    arn      = aws_sqs_queue.test[%[2]d].arn
     role_arn = aws_iam_role.test.arn
   }
 }
-`, namePrefix),
+`, name, i),
 	)
 }
 
-func testAccScheduleConfig_scheduleExpression(name, expression string) string {
+func testAccScheduleConfig_targetARNLambda(name string, i int) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
-resource "aws_sqs_queue" "test" {}
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = {
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "lambda.${data.aws_partition.main.dns_suffix}"
+      }
+    }
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  count = 2
+
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = "%[1]s-${count.index}"
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
 
 resource "aws_scheduler_schedule" "test" {
   name = %[1]q
@@ -1891,22 +3414,43 @@ resource "aws_scheduler_schedule" "test" {
     mode = "OFF"
   }
 
-  schedule_expression = %[2]q
+  schedule_expression = "rate(1 hour)"
 
   target {
-    arn      = aws_sqs_queue.test.arn
+    arn      = aws_lambda_function.test[%[2]d].arn
     role_arn = aws_iam_role.test.arn
   }
 }
-`, name, expression),
+`, name, i),
 	)
 }
 
-func testAccScheduleConfig_scheduleExpressionTimezone(name, timezone string) string {
+func testAccScheduleConfig_targetSQSParametersLambda(name string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
-resource "aws_sqs_queue" "test" {}
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = {
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "lambda.${data.aws_partition.main.dns_suffix}"
+      }
+    }
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
 
 resource "aws_scheduler_schedule" "test" {
   name = %[1]q
@@ -1917,23 +3461,29 @@ resource "aws_scheduler_schedule" "test" {
 
   schedule_expression = "rate(1 hour)"
 
-  schedule_expression_timezone = %[2]q
-
   target {
-    arn      = aws_sqs_queue.test.arn
+    arn      = aws_lambda_function.test.arn
     role_arn = aws_iam_role.test.arn
+
+    sqs_parameters {
+      message_group_id = "test"
+    }
   }
 }
-`, name, timezone),
+`, name),
 	)
 }
 
-func testAccScheduleConfig_startDate(name, startDate string) string {
+func testAccScheduleConfig_targetDeadLetterConfig(name string, index int) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {}
 
+resource "aws_sqs_queue" "dlq" {
+  count = 2
+}
+
 resource "aws_scheduler_schedule" "test" {
   name = %[1]q
 
@@ -1943,22 +3493,29 @@ resource "aws_scheduler_schedule" "test" {
 
   schedule_expression = "rate(1 hour)"
 
-  start_date = %[2]q
-
   target {
     arn      = aws_sqs_queue.test.arn
     role_arn = aws_iam_role.test.arn
+
+    dead_letter_config {
+      arn = aws_sqs_queue.dlq[%[2]d].arn
+    }
   }
 }
-`, name, startDate),
+`, name, index),
 	)
 }
 
-func testAccScheduleConfig_state(name, state string) string {
+func testAccScheduleConfig_targetSQSParametersQueueName(name, queueName string, fifoQueue bool) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
-resource "aws_sqs_queue" "test" {}
+data "aws_region" "main" {}
+
+resource "aws_sqs_queue" "test" {
+  name       = %[2]q
+  fifo_queue = %[3]t
+}
 
 resource "aws_scheduler_schedule" "test" {
   name = %[1]q
@@ -1969,24 +3526,27 @@ resource "aws_scheduler_schedule" "test" {
 
   schedule_expression = "rate(1 hour)"
 
-  state = %[2]q
-
   target {
-    arn      = aws_sqs_queue.test.arn
+    # The queue ARN is constructed so that it's known at plan time.
+    arn      = "arn:${data.aws_partition.main.partition}:sqs:${data.aws_region.main.name}:${data.aws_caller_identity.main.account_id}:%[2]s"
     role_arn = aws_iam_role.test.arn
+
+    sqs_parameters {
+      message_group_id = "test"
+    }
   }
+
+  depends_on = [aws_sqs_queue.test]
 }
-`, name, state),
+`, name, queueName, fifoQueue),
 	)
 }
 
-func testAccScheduleConfig_targetARN(name string, i int) string {
+func testAccScheduleConfig_targetDeadLetterConfigARN(name, dlqARN string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
-resource "aws_sqs_queue" "test" {
-  count = 2
-}
+resource "aws_sqs_queue" "test" {}
 
 resource "aws_scheduler_schedule" "test" {
   name = %[1]q
@@ -1998,22 +3558,44 @@ resource "aws_scheduler_schedule" "test" {
   schedule_expression = "rate(1 hour)"
 
   target {
-    arn      = aws_sqs_queue.test[%[2]d].arn
+    arn      = aws_sqs_queue.test.arn
     role_arn = aws_iam_role.test.arn
+
+    dead_letter_config {
+      arn = %[2]q
+    }
   }
 }
-`, name, i),
+`, name, dlqARN),
 	)
 }
 
-func testAccScheduleConfig_targetDeadLetterConfig(name string, index int) string {
+func testAccScheduleConfig_targetECSParameters1(name string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
-resource "aws_sqs_queue" "test" {}
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
 
-resource "aws_sqs_queue" "dlq" {
-  count = 2
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  cpu                      = 256
+  memory                   = 512
+  requires_compatibilities = ["FARGATE"]
+  network_mode             = "awsvpc"
+
+  container_definitions = <<EOF
+[
+  {
+    "name": "first",
+    "image": "service-first",
+    "cpu": 10,
+    "memory": 512,
+    "essential": true
+  }
+]
+EOF
 }
 
 resource "aws_scheduler_schedule" "test" {
@@ -2026,19 +3608,19 @@ resource "aws_scheduler_schedule" "test" {
   schedule_expression = "rate(1 hour)"
 
   target {
-    arn      = aws_sqs_queue.test.arn
+    arn      = aws_ecs_cluster.test.arn
     role_arn = aws_iam_role.test.arn
 
-    dead_letter_config {
-      arn = aws_sqs_queue.dlq[%[2]d].arn
+    ecs_parameters {
+      task_definition_arn = aws_ecs_task_definition.test.arn
     }
   }
 }
-`, name, index),
+`, name),
 	)
 }
 
-func testAccScheduleConfig_targetECSParameters1(name string) string {
+func testAccScheduleConfig_targetECSParametersContainerOverrides(name string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
 		fmt.Sprintf(`
@@ -2079,8 +3661,17 @@ resource "aws_scheduler_schedule" "test" {
     arn      = aws_ecs_cluster.test.arn
     role_arn = aws_iam_role.test.arn
 
+    input = jsonencode({
+      containerOverrides = [{
+        name    = "first"
+        command = ["echo", "hello"]
+      }]
+    })
+
     ecs_parameters {
       task_definition_arn = aws_ecs_task_definition.test.arn
+      group               = "my-task-group"
+      task_count          = 2
     }
   }
 }
@@ -2395,6 +3986,126 @@ resource "aws_scheduler_schedule" "test" {
 	)
 }
 
+func testAccScheduleConfig_targetInputRaw(name, input string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = "arn:${data.aws_partition.main.partition}:scheduler:::aws-sdk:sqs:sendMessage"
+    role_arn = aws_iam_role.test.arn
+
+    input = %[2]q
+  }
+}
+`, name, input),
+	)
+}
+
+func testAccScheduleConfig_targetInputSQS(name, input string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+
+    input = %[2]q
+  }
+}
+`, name, input),
+	)
+}
+
+func testAccScheduleConfig_targetInputOmittedUniversal(name string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = "arn:${data.aws_partition.main.partition}:scheduler:::aws-sdk:sqs:listQueues"
+    role_arn = aws_iam_role.test.arn
+  }
+}
+`, name),
+	)
+}
+
+func testAccScheduleConfig_targetInputLambda(name, input string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = {
+      Effect = "Allow"
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "lambda.${data.aws_partition.main.dns_suffix}"
+      }
+    }
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_lambda_function.test.arn
+    role_arn = aws_iam_role.test.arn
+    input    = %[2]q
+  }
+}
+`, name, input),
+	)
+}
+
 func testAccScheduleConfig_targetKinesisParameters(scheduleName, streamName, partitionKey string) string {
 	return acctest.ConfigCompose(
 		testAccScheduleConfig_base,
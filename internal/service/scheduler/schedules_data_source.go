@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_scheduler_schedules", name="Schedules")
+func dataSourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSchedulesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrGroupName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schedules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrGroupName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	DSNameSchedules = "Schedules Data Source"
+)
+
+func dataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+
+	// Without a group name, schedules in all groups are listed.
+	in := &scheduler.ListSchedulesInput{}
+	id := meta.(*conns.AWSClient).Region
+	if v, ok := d.GetOk(names.AttrGroupName); ok {
+		in.GroupName = aws.String(v.(string))
+		id = v.(string)
+	}
+
+	out, err := findSchedules(ctx, conn, in)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionReading, DSNameSchedules, id, err)
+	}
+
+	d.SetId(id)
+	if err := d.Set("schedules", flattenScheduleSummaries(out)); err != nil {
+		return create.AppendDiagError(diags, names.Scheduler, create.ErrActionSetting, DSNameSchedules, id, err)
+	}
+
+	return diags
+}
+
+func flattenScheduleSummaries(apiObjects []types.ScheduleSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:       aws.ToString(apiObject.Arn),
+			names.AttrGroupName: aws.ToString(apiObject.GroupName),
+			names.AttrName:      aws.ToString(apiObject.Name),
+			names.AttrState:     string(apiObject.State),
+		})
+	}
+
+	return tfList
+}
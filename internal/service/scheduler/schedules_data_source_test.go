@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSchedulerSchedulesDataSource_allGroups(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_scheduler_schedules.test"
+	groupDataSourceName := "data.aws_scheduler_schedules.group"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesDataSourceConfig_allGroups(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "schedules.*", map[string]string{
+						names.AttrGroupName: rName1,
+						names.AttrName:      rName1,
+						names.AttrState:     "ENABLED",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "schedules.*", map[string]string{
+						names.AttrGroupName: rName2,
+						names.AttrName:      rName2,
+						names.AttrState:     "ENABLED",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "schedules.*.arn", "aws_scheduler_schedule.test1", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "schedules.*.arn", "aws_scheduler_schedule.test2", names.AttrARN),
+					resource.TestCheckResourceAttr(groupDataSourceName, "schedules.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(groupDataSourceName, "schedules.0.arn", "aws_scheduler_schedule.test2", names.AttrARN),
+					resource.TestCheckResourceAttr(groupDataSourceName, "schedules.0.group_name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchedulesDataSourceConfig_allGroups(rName1, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule_group" "test1" {
+  name = %[1]q
+}
+
+resource "aws_scheduler_schedule_group" "test2" {
+  name = %[2]q
+}
+
+resource "aws_scheduler_schedule" "test1" {
+  name       = %[1]q
+  group_name = aws_scheduler_schedule_group.test1.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+
+resource "aws_scheduler_schedule" "test2" {
+  name       = %[2]q
+  group_name = aws_scheduler_schedule_group.test2.name
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    arn      = aws_sqs_queue.test.arn
+    role_arn = aws_iam_role.test.arn
+  }
+}
+
+data "aws_scheduler_schedules" "test" {
+  depends_on = [aws_scheduler_schedule.test1, aws_scheduler_schedule.test2]
+}
+
+data "aws_scheduler_schedules" "group" {
+  group_name = aws_scheduler_schedule_group.test2.name
+
+  depends_on = [aws_scheduler_schedule.test2]
+}
+`, rName1, rName2),
+	)
+}
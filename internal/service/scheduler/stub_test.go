@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// newStubClient returns a client whose operations never reach AWS.
+// Each call is answered by f, which receives the operation input and returns its output or error.
+func newStubClient(f func(params interface{}) (interface{}, error)) *scheduler.Client {
+	return scheduler.New(scheduler.Options{
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				return stack.Initialize.Add(
+					middleware.InitializeMiddlewareFunc(
+						"Test: Stub Client",
+						func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+							result, err := f(in.Parameters)
+							if err != nil {
+								return middleware.InitializeOutput{}, middleware.Metadata{}, err
+							}
+
+							return middleware.InitializeOutput{Result: result}, middleware.Metadata{}, nil
+						},
+					),
+					middleware.Before,
+				)
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var (
+	contextAttributeRegexp             = regexache.MustCompile(`<aws\.scheduler\.(attempt-number|execution-id|schedule-arn|scheduled-time)>`)
+	contextAttributeLikeRegexp         = regexache.MustCompile(`<aws\.scheduler\.[^<>\s]*>?`)
+	sqsQueueNameRegexp                 = regexache.MustCompile(`^[0-9A-Za-z_-]{1,80}(\.fifo)?$`)
+	universalTargetServiceActionRegexp = regexache.MustCompile(`^arn:[0-9a-z-]+:scheduler:::aws-sdk:[0-9a-z-]+:[a-z][0-9A-Za-z]*$`)
+)
+
+// validTargetInputJSON returns whether the specified target input is a valid JSON document
+// once any Scheduler context attributes, e.g. "<aws.scheduler.execution-id>", have been substituted.
+// Context attributes are substituted as text, so they are only valid within JSON strings.
+func validTargetInputJSON(input string) bool {
+	return json.Valid([]byte(contextAttributeRegexp.ReplaceAllLiteralString(input, "x")))
+}
+
+// validTargetInputContextAttributes warns about "<aws.scheduler.*>" placeholders in target input
+// that are not Scheduler context attributes. Such placeholders are passed to the target literally.
+func validTargetInputContextAttributes(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, v := range contextAttributeLikeRegexp.FindAllString(value, -1) {
+		if !contextAttributeRegexp.MatchString(v) {
+			ws = append(ws, fmt.Sprintf("%q contains %q, which is not a recognized context attribute and will be passed to the target literally", k, v))
+		}
+	}
+
+	return
+}
+
+// validRoleARN validates that an ARN is that of an IAM role, e.g. "arn:aws:iam::123456789012:role/my-role".
+// The role may be in any account, as Scheduler can assume roles in other accounts.
+func validRoleARN(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidARN(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	value := v.(string)
+	if parsedARN, err := arn.Parse(value); err != nil || parsedARN.Service != "iam" || parsedARN.Region != "" || parsedARN.AccountID == "" || !strings.HasPrefix(parsedARN.Resource, "role/") {
+		errors = append(errors, fmt.Errorf("%q must be the ARN of an IAM role: %q", k, value))
+	}
+
+	return
+}
+
+// validDeadLetterConfigARN validates that a dead-letter queue ARN is that of an SQS queue,
+// e.g. "arn:aws:sqs:us-west-2:123456789012:my-queue".
+func validDeadLetterConfigARN(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidARN(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	value := v.(string)
+	if parsedARN, err := arn.Parse(value); err != nil || parsedARN.Service != "sqs" || parsedARN.Region == "" || parsedARN.AccountID == "" || !sqsQueueNameRegexp.MatchString(parsedARN.Resource) {
+		errors = append(errors, fmt.Errorf("%q must be the ARN of an SQS queue: %q", k, value))
+	}
+
+	return
+}
+
+// validTargetARN validates a schedule target ARN.
+// A universal target ARN must name a service and a camelCase API action,
+// e.g. "arn:aws:scheduler:::aws-sdk:sqs:sendMessage" or "arn:aws:scheduler:::aws-sdk:lambda:invoke".
+func validTargetARN(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = verify.ValidARN(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	value := v.(string)
+	if isUniversalTargetARN(value) && !universalTargetServiceActionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a universal target ARN of the form \"arn:aws:scheduler:::aws-sdk:service:apiAction\", where apiAction is in camelCase (e.g. \"sqs:sendMessage\"): %q",
+			k, value))
+	}
+
+	return
+}
+
+// isLambdaFunctionARN returns whether the specified ARN is that of a Lambda function, version or alias,
+// e.g. "arn:aws:lambda:us-west-2:123456789012:function:my-function".
+func isLambdaFunctionARN(v string) bool {
+	parsedARN, err := arn.Parse(v)
+
+	return err == nil && parsedARN.Service == "lambda" && strings.HasPrefix(parsedARN.Resource, "function:")
+}
+
+// isQueueARN returns whether the specified ARN is that of an SQS queue,
+// e.g. "arn:aws:sqs:us-west-2:123456789012:my-queue".
+func isQueueARN(v string) bool {
+	parsedARN, err := arn.Parse(v)
+
+	return err == nil && parsedARN.Service == "sqs" && sqsQueueNameRegexp.MatchString(parsedARN.Resource)
+}
+
+// isFIFOQueueARN returns whether the specified ARN is that of an SQS FIFO queue,
+// e.g. "arn:aws:sqs:us-west-2:123456789012:my-queue.fifo".
+func isFIFOQueueARN(v string) bool {
+	return isQueueARN(v) && strings.HasSuffix(v, ".fifo")
+}
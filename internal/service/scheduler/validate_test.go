@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidDeadLetterConfigARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:sqs:us-west-2:123456789012:test",                //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:test-dlq_1.fifo",     //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:sqs:us-gov-west-1:123456789012:test-dlq", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validDeadLetterConfigARN(v, names.AttrARN)
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid dead-letter queue ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"arn:aws:sns:us-west-2:123456789012:test",               //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2::test",                           //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs::123456789012:test",                        //lintignore:AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:test/queue",         //lintignore:AWSAT003,AWSAT005
+		"https://sqs.us-west-2.amazonaws.com/123456789012/test", //lintignore:AWSAT003
+		"arn:aws:sqs:us-west-2:123456789012:",                   //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validDeadLetterConfigARN(v, names.AttrARN)
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid dead-letter queue ARN", v)
+		}
+	}
+}
+
+func TestValidRoleARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:iam::123456789012:role/test",                       //lintignore:AWSAT005
+		"arn:aws:iam::210987654321:role/cross-account/test",         //lintignore:AWSAT005
+		"arn:aws-us-gov:iam::123456789012:role/service-role/test-1", //lintignore:AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validRoleARN(v, names.AttrRoleARN)
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM role ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"arn:aws:iam::123456789012:user/test",          //lintignore:AWSAT005
+		"arn:aws:iam:us-west-2:123456789012:role/test", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam:::role/test",                      //lintignore:AWSAT005
+		"arn:aws:sts::123456789012:assumed-role/test",  //lintignore:AWSAT005
+		"test",
+	}
+	for _, v := range invalidARNs {
+		_, errors := validRoleARN(v, names.AttrRoleARN)
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM role ARN", v)
+		}
+	}
+}
+
+func TestValidTargetARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:scheduler:::aws-sdk:lambda:invoke",                             //lintignore:AWSAT005
+		"arn:aws:scheduler:::aws-sdk:sqs:sendMessage",                           //lintignore:AWSAT005
+		"arn:aws-us-gov:scheduler:::aws-sdk:inspector2:listFindings",            //lintignore:AWSAT005
+		"arn:aws:lambda:us-west-2:123456789012:function:test",                   //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:test",                               //lintignore:AWSAT003,AWSAT005
+		"arn:aws:states:us-west-2:123456789012:stateMachine:test-state-machine", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validTargetARN(v, names.AttrARN)
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid schedule target ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"arn:aws:scheduler:::aws-sdk:lambda:Invoke",   //lintignore:AWSAT005
+		"arn:aws:scheduler:::aws-sdk:sqs:SendMessage", //lintignore:AWSAT005
+		"arn:aws:scheduler:::aws-sdk:sqs",             //lintignore:AWSAT005
+		"arn:aws:scheduler:::aws-sdk:Lambda:invoke",   //lintignore:AWSAT005
+		"not-an-arn",
+	}
+	for _, v := range invalidARNs {
+		_, errors := validTargetARN(v, names.AttrARN)
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid schedule target ARN", v)
+		}
+	}
+}
+
+func TestValidTargetInputJSON(t *testing.T) {
+	t.Parallel()
+
+	validInputs := []string{
+		`{}`,
+		`{"MessageBody": "test", "QueueUrl": "url"}`,
+		`{"MessageBody": "<aws.scheduler.execution-id>"}`,
+		`{"MessageBody": "Attempt <aws.scheduler.attempt-number> of <aws.scheduler.schedule-arn>"}`,
+	}
+	for _, v := range validInputs {
+		if !validTargetInputJSON(v) {
+			t.Fatalf("%q should be a valid target input", v)
+		}
+	}
+
+	invalidInputs := []string{
+		`not JSON`,
+		`{"MessageBody": "test",`,
+		`{"MessageBody": <aws.scheduler.unknown>}`,
+		`{"MessageBody": <aws.scheduler.execution-id}`,
+		`{"Id": <aws.scheduler.execution-id>}`,
+		`{"Attempt": <aws.scheduler.attempt-number>}`,
+	}
+	for _, v := range invalidInputs {
+		if validTargetInputJSON(v) {
+			t.Fatalf("%q should be an invalid target input", v)
+		}
+	}
+}
+
+func TestValidateTargetInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn         string
+		input       string
+		expectError bool
+	}{
+		"empty input": {
+			arn: "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+		},
+		"SQS queue text": {
+			arn:   "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			input: `[x]`,
+		},
+		"SQS queue unterminated object": {
+			arn:   "arn:aws:sqs:us-west-2:123456789012:test", //lintignore:AWSAT003,AWSAT005
+			input: `{not json`,
+		},
+		"universal target JSON": {
+			arn:   "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			input: `{"MessageBody": "test"}`,
+		},
+		"universal target text": {
+			arn:         "arn:aws:scheduler:::aws-sdk:sqs:sendMessage", //lintignore:AWSAT005
+			input:       `[x]`,
+			expectError: true,
+		},
+		"Lambda function text": {
+			arn:         "arn:aws:lambda:us-west-2:123456789012:function:test", //lintignore:AWSAT003,AWSAT005
+			input:       `{not json`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateTargetInput(testCase.arn, testCase.input)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("got error %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestIsQueueARN(t *testing.T) {
+	t.Parallel()
+
+	queueARNs := []string{
+		"arn:aws:sqs:us-west-2:123456789012:test",                 //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:sqs:us-gov-west-1:123456789012:test.fifo", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range queueARNs {
+		if !isQueueARN(v) {
+			t.Fatalf("%q should be an SQS queue ARN", v)
+		}
+	}
+
+	otherARNs := []string{
+		"arn:aws:lambda:us-west-2:123456789012:function:test",   //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sns:us-west-2:123456789012:test",               //lintignore:AWSAT003,AWSAT005
+		"arn:aws:scheduler:::aws-sdk:sqs:sendMessage",           //lintignore:AWSAT005
+		"https://sqs.us-west-2.amazonaws.com/123456789012/test", //lintignore:AWSAT003
+	}
+	for _, v := range otherARNs {
+		if isQueueARN(v) {
+			t.Fatalf("%q should not be an SQS queue ARN", v)
+		}
+	}
+}
+
+func TestIsFIFOQueueARN(t *testing.T) {
+	t.Parallel()
+
+	fifoARNs := []string{
+		"arn:aws:sqs:us-west-2:123456789012:test.fifo",            //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:sqs:us-gov-west-1:123456789012:test.fifo", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range fifoARNs {
+		if !isFIFOQueueARN(v) {
+			t.Fatalf("%q should be an SQS FIFO queue ARN", v)
+		}
+	}
+
+	otherARNs := []string{
+		"arn:aws:sqs:us-west-2:123456789012:test",               //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sns:us-west-2:123456789012:test.fifo",          //lintignore:AWSAT003,AWSAT005
+		"arn:aws:scheduler:::aws-sdk:sqs:sendMessage",           //lintignore:AWSAT005
+		"https://sqs.us-west-2.amazonaws.com/123456789012/test", //lintignore:AWSAT003
+	}
+	for _, v := range otherARNs {
+		if isFIFOQueueARN(v) {
+			t.Fatalf("%q should not be an SQS FIFO queue ARN", v)
+		}
+	}
+}
+
+func TestIsLambdaFunctionARN(t *testing.T) {
+	t.Parallel()
+
+	functionARNs := []string{
+		"arn:aws:lambda:us-west-2:123456789012:function:test",                 //lintignore:AWSAT003,AWSAT005
+		"arn:aws:lambda:us-west-2:123456789012:function:test:1",               //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:lambda:us-gov-west-1:123456789012:function:test:live", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range functionARNs {
+		if !isLambdaFunctionARN(v) {
+			t.Fatalf("%q should be a Lambda function ARN", v)
+		}
+	}
+
+	otherARNs := []string{
+		"arn:aws:lambda:us-west-2:123456789012:layer:test:1", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:sqs:us-west-2:123456789012:test",            //lintignore:AWSAT003,AWSAT005
+		"arn:aws:scheduler:::aws-sdk:lambda:invoke",          //lintignore:AWSAT005
+		"test",
+	}
+	for _, v := range otherARNs {
+		if isLambdaFunctionARN(v) {
+			t.Fatalf("%q should not be a Lambda function ARN", v)
+		}
+	}
+}
+
+func TestValidTargetInputContextAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input            string
+		expectedWarnings int
+	}{
+		{input: `{"MessageBody": "test"}`},
+		{input: `{"Id": "<aws.scheduler.execution-id>", "Time": "<aws.scheduler.scheduled-time>"}`},
+		{input: `Attempt <aws.scheduler.attempt-number> of <aws.scheduler.schedule-arn>`},
+		{input: `{"Id": "<aws.scheduler.execution-id>", "Name": "<aws.scheduler.schedule-name>"}`, expectedWarnings: 1},
+		{input: `{"Id": "<aws.scheduler.execution-id"}`, expectedWarnings: 1},
+		{input: `<aws.scheduler.ScheduledTime> <aws.scheduler.>`, expectedWarnings: 2},
+	}
+
+	for _, testCase := range testCases {
+		ws, errs := validTargetInputContextAttributes(testCase.input, "input")
+
+		if len(errs) != 0 {
+			t.Errorf("%q: unexpected errors: %q", testCase.input, errs)
+		}
+
+		if got, want := len(ws), testCase.expectedWarnings; got != want {
+			t.Errorf("%q: got %d warnings (%q), want %d", testCase.input, got, ws, want)
+		}
+	}
+}